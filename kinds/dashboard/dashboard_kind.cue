@@ -520,6 +520,9 @@ lineage: schemas: [{
 			// Whether to display the panel without a background.
 			transparent?: bool | *false
 
+			// Border of the panel. `none` hides the border, `shadow` replaces it with a drop shadow.
+			borderStyle?: "none" | "solid" | "shadow"
+
 			// The datasource used in all targets.
 			datasource?: #DataSourceRef
 
@@ -30,6 +30,9 @@ lineage: schemas: [{
 
 			// Cookie preferences
 			cookiePreferences?: #CookiePreferences
+
+			// UID for the default data source
+			defaultDatasourceUID?: string
 		} @cuetsy(kind="interface")
 
 		#QueryHistoryPreference: {
@@ -1,4 +1,4 @@
-import { localTimeFormat, systemDateFormats } from './formats';
+import { localTimeFormat, systemDateFormats, SystemDateFormatsState } from './formats';
 
 describe('Date Formats', () => {
   it('localTimeFormat', () => {
@@ -63,3 +63,27 @@ describe('systemDateFormats', () => {
     expect(systemDateFormats.interval.year).toBe('YYYY');
   });
 });
+
+describe('systemDateFormats interval formats', () => {
+  it('overrides the configured interval formats', () => {
+    const formats = new SystemDateFormatsState();
+    formats.update({
+      fullDate: 'YYYY-MM-DD HH:mm:ss',
+      interval: { ...formats.interval },
+      intervalFormats: { day: 'ddd MM/DD', hour: 'ddd HH:mm' },
+      useBrowserLocale: false,
+    });
+
+    expect(formats.interval.day).toBe('ddd MM/DD');
+    expect(formats.interval.hour).toBe('ddd HH:mm');
+    expect(formats.interval.minute).toBe('HH:mm');
+  });
+
+  it('keeps the interval formats when there are no overrides', () => {
+    const formats = new SystemDateFormatsState();
+    const interval = { ...formats.interval };
+    formats.update({ fullDate: 'YYYY-MM-DD HH:mm:ss', interval, useBrowserLocale: false });
+
+    expect(formats.interval).toEqual(interval);
+  });
+});
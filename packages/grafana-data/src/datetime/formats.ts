@@ -9,6 +9,8 @@ export interface SystemDateFormatSettings {
     month: string;
     year: string;
   };
+  /** Overrides the interval formats per unit, takes precedence over the browser locale formats */
+  intervalFormats?: Partial<SystemDateFormatSettings['interval']>;
   useBrowserLocale: boolean;
 }
 
@@ -35,6 +37,10 @@ export class SystemDateFormatsState {
     if (settings.useBrowserLocale) {
       this.useBrowserLocale();
     }
+
+    if (settings.intervalFormats) {
+      this.interval = { ...this.interval, ...settings.intervalFormats };
+    }
   }
 
   useBrowserLocale() {
@@ -21,6 +21,9 @@ export interface BuildInfo {
   latestVersion: string;
   hasUpdate: boolean;
   hideVersion: boolean;
+  /** Set when one or more server subsystems failed their health probe */
+  degradedMode?: boolean;
+  degradedReasons?: string[];
 }
 
 /**
@@ -66,6 +69,10 @@ export interface UnifiedAlertingConfig {
   alertStateHistoryBackend?: string;
   // will be undefined if implementation is not "multiple"
   alertStateHistoryPrimary?: string;
+  // will be undefined if no default contact point is configured
+  defaultAlertContactPoint?: string;
+  // will be undefined or 0 if the number of rules per group is unlimited
+  maxRulesPerGroup?: number;
 }
 
 /** Supported OAuth services
@@ -221,6 +228,11 @@ export interface GrafanaConfig {
   rudderstackConfigUrl: string | undefined;
   rudderstackIntegrationsUrl: string | undefined;
   sqlConnectionLimits: SqlConnectionLimits;
+  secretsManager: SecretsManagerSettings;
+  extensions: Record<string, unknown>;
+  navigation: NavigationSettings;
+  avatars: AvatarSettings;
+  rendering: RenderingSettings;
 }
 
 export interface SqlConnectionLimits {
@@ -229,6 +241,52 @@ export interface SqlConnectionLimits {
   connMaxLifetime: number;
 }
 
+/**
+ * Describes the secrets manager plugin. Everything but `enabled` is only sent to server admins.
+ *
+ * @internal
+ */
+export interface SecretsManagerSettings {
+  enabled: boolean;
+  pluginId?: string;
+  migrationStatus?: 'not_started' | 'in_progress' | 'complete' | 'error';
+  backwardsCompatibilityEnabled?: boolean;
+}
+
+/**
+ * Describes the navigation customization for the current org.
+ *
+ * @internal
+ */
+export interface NavigationSettings {
+  hiddenSections: string[];
+  pinnedItems: string[];
+}
+
+/**
+ * Describes the image renderer and the formats it supports.
+ *
+ * @internal
+ */
+export interface RenderingSettings {
+  available: boolean;
+  version: string;
+  supportedFormats: Array<'png' | 'pdf' | 'csv'>;
+  concurrencyLimit: number;
+  defaultTimeoutSeconds: number;
+}
+
+/**
+ * Describes where user avatars come from. `customSourceUrl` is empty unless a custom avatar source is configured.
+ *
+ * @internal
+ */
+export interface AvatarSettings {
+  gravatarEnabled: boolean;
+  defaultAvatarUrl: string;
+  customSourceUrl: string;
+}
+
 export interface AuthSettings {
   OAuthSkipOrgRoleUpdateSync?: boolean;
   SAMLSkipOrgRoleSync?: boolean;
@@ -45,6 +45,10 @@ export type {
   GrafanaConfig,
   BuildInfo,
   LicenseInfo,
+  SecretsManagerSettings,
+  NavigationSettings,
+  AvatarSettings,
+  RenderingSettings,
 } from './config';
 export type { FeatureToggles } from './featureToggles.gen';
 export * from './alerts';
@@ -2,6 +2,7 @@ import { merge } from 'lodash';
 
 import {
   AuthSettings,
+  AvatarSettings,
   BootData,
   BuildInfo,
   DataSourceInstanceSettings,
@@ -11,8 +12,13 @@ import {
   GrafanaTheme2,
   LicenseInfo,
   MapLayerOptions,
+  NavigationSettings,
   OAuthSettings,
   PanelPluginMeta,
+  PreferredVisualisationType,
+  RenderingSettings,
+  SecretsManagerSettings,
+  SpecialValueMap,
   systemDateFormats,
   SystemDateFormatSettings,
   getThemeById,
@@ -94,7 +100,9 @@ export class GrafanaBootConfig implements GrafanaConfig {
   featureToggles: FeatureToggles = {};
   anonymousEnabled = false;
   licenseInfo: LicenseInfo = {} as LicenseInfo;
+  /** @deprecated Use `rendering.available` */
   rendererAvailable = false;
+  /** @deprecated Use `rendering.version` */
   rendererVersion = '';
   secretsManagerPluginEnabled = false;
   supportBundlesEnabled = false;
@@ -132,9 +140,12 @@ export class GrafanaBootConfig implements GrafanaConfig {
     minInterval: '',
     alertStateHistoryBackend: undefined,
     alertStateHistoryPrimary: undefined,
+    defaultAlertContactPoint: undefined,
+    maxRulesPerGroup: 0,
   };
   applicationInsightsConnectionString?: string;
   applicationInsightsEndpointUrl?: string;
+  applicationInsightsSamplingPercentage = 100;
   recordedQueries = {
     enabled: true,
   };
@@ -160,9 +171,81 @@ export class GrafanaBootConfig implements GrafanaConfig {
     maxIdleConns: 100,
     connMaxLifetime: 14400,
   };
+  secretsManager: SecretsManagerSettings = { enabled: false };
+  extensions: Record<string, unknown> = {};
+  navigation: NavigationSettings = { hiddenSections: [], pinnedItems: [] };
+  rendering: RenderingSettings = {
+    available: false,
+    version: '',
+    supportedFormats: [],
+    concurrencyLimit: 30,
+    defaultTimeoutSeconds: 60,
+  };
+  avatars: AvatarSettings = { gravatarEnabled: true, defaultAvatarUrl: 'public/img/user_profile.png', customSourceUrl: '' };
 
   tokenExpirationDayLimit: undefined;
   disableFrontendSandboxForPlugins: string[] = [];
+  availableLocales: string[] = [];
+  defaultLocale = 'en-US';
+  defaultLogsSortOrder: 'asc' | 'desc' | '' = '';
+  apiVersionHash = '';
+  angularPlugins: string[] = [];
+  provisioningReloadIntervalSeconds = 0;
+  autoLogoutOnIdle = false;
+  idleTimeoutSeconds = 0;
+  signoutRedirectUrl = '';
+  smtpEnabled = false;
+  resetPasswordEnabled = true;
+  maxSearchResults = 0;
+  mustChangePassword = false;
+  teamPickerPageSize = 0;
+  serverTimeMillis = 0;
+  serverTimezone = 'UTC';
+  namespace = '';
+  orgId = 0;
+  orgName = '';
+  unifiedStorageEnabled = false;
+  maxConcurrentTransformations = 0;
+  defaultTextPanelContent = '';
+  maxTemplateVariables = 0;
+  maxDashboardPanels = 0;
+  maxPanelLinks = 0;
+  pauseRefreshWhileEditing = false;
+  maxDatasourceConnections = 0;
+  defaultReduceCalc = '';
+  maxEmbedRequestsPerMinute = 0;
+  maxSessionQueryHistory = 0;
+  maxExternalSnapshots = 0;
+  defaultPanelMinInterval = '';
+  maxConcurrentAnnotationQueries = 0;
+  defaultPanelBorderStyle: '' | 'none' | 'solid' | 'shadow' = '';
+  minVariableRefreshIntervalSeconds = 0;
+  defaultAllValue = '';
+  maxDashboardRenderQueue = 0;
+  defaultFillOpacity = 0;
+  maxFolderTreeNodes = 0;
+  defaultDashboardLinkIcon = '';
+  defaultDashboardLinkTooltip = '';
+  maxInlineSVGBytes = 0;
+  defaultExploreRangeSelectAction: '' | 'zoom' | 'annotate' | 'copy' = '';
+  defaultShowPanelDescription = false;
+  maxBreadcrumbDepth = 0;
+  defaultDatasourceVariableFilter = '';
+  maxDashboardFields = 0;
+  defaultExploreSupplementaryQueries = true;
+  maxVariablesPerQuery = 0;
+  defaultTimeShift = '';
+  defaultRepeatScope: '' | 'panel' | 'row' = '';
+  maxSearchQueryLength = 0;
+  maxDatasourceInstancesPerPlugin = 0;
+  maxRenderedAnnotations = 0;
+  defaultVariableMultiSelect = false;
+  maxRefreshesBeforePause = 0;
+  defaultThresholdSteps: Array<{ color: string; value: number | null }> = [];
+  defaultSpecialValueMappings: SpecialValueMap[] = [];
+  logLevelColorMap: Record<string, string> = {};
+  defaultExploreVizByDatasourceType: Record<string, PreferredVisualisationType> = {};
+  exploreRowLimitByType: Record<string, number> = {};
 
   constructor(options: GrafanaBootConfig) {
     this.bootData = options.bootData;
@@ -638,6 +638,10 @@ export const defaultDashboardCursorSync: DashboardCursorSync = DashboardCursorSy
  * Dashboard panels are the basic visualization building blocks.
  */
 export interface Panel {
+  /**
+   * Border of the panel. `none` hides the border, `shadow` replaces it with a drop shadow.
+   */
+  borderStyle?: ('none' | 'solid' | 'shadow');
   /**
    * The datasource used in all targets.
    */
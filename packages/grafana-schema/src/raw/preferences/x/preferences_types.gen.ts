@@ -26,6 +26,10 @@ export interface Preferences {
    * Cookie preferences
    */
   cookiePreferences?: CookiePreferences;
+  /**
+   * UID for the default data source
+   */
+  defaultDatasourceUID?: string;
   /**
    * UID for the home dashboard
    */
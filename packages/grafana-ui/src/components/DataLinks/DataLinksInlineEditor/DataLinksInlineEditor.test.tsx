@@ -0,0 +1,42 @@
+import { render, screen } from '@testing-library/react';
+import React from 'react';
+
+import { DataLink } from '@grafana/data';
+
+import { DataLinksInlineEditor, DataLinksInlineEditorProps } from './DataLinksInlineEditor';
+
+const links: DataLink[] = [
+  { title: 'First', url: 'http://localhost:3000/first' },
+  { title: 'Second', url: 'http://localhost:3000/second' },
+];
+
+function setupTestContext(options: Partial<DataLinksInlineEditorProps>) {
+  const defaults: DataLinksInlineEditorProps = {
+    links,
+    onChange: jest.fn(),
+    getSuggestions: jest.fn().mockReturnValue([]),
+    data: [],
+  };
+
+  render(<DataLinksInlineEditor {...defaults} {...options} />);
+}
+
+describe('DataLinksInlineEditor', () => {
+  it('allows adding links when there is no limit', () => {
+    setupTestContext({});
+
+    expect(screen.getByRole('button', { name: /add link/i })).toBeEnabled();
+  });
+
+  it('allows adding links below the limit', () => {
+    setupTestContext({ maxLinks: 3 });
+
+    expect(screen.getByRole('button', { name: /add link/i })).toBeEnabled();
+  });
+
+  it('does not allow adding links once the limit is reached', () => {
+    setupTestContext({ maxLinks: 2 });
+
+    expect(screen.getByRole('button', { name: /add link/i })).toBeDisabled();
+  });
+});
@@ -11,14 +11,22 @@ import { Modal } from '../../Modal/Modal';
 import { DataLinkEditorModalContent } from './DataLinkEditorModalContent';
 import { DataLinksListItem } from './DataLinksListItem';
 
-interface DataLinksInlineEditorProps {
+export interface DataLinksInlineEditorProps {
   links?: DataLink[];
   onChange: (links: DataLink[]) => void;
   getSuggestions: () => VariableSuggestion[];
   data: DataFrame[];
+  /** Maximum number of links that can be added, 0 or undefined means unlimited */
+  maxLinks?: number;
 }
 
-export const DataLinksInlineEditor = ({ links, onChange, getSuggestions, data }: DataLinksInlineEditorProps) => {
+export const DataLinksInlineEditor = ({
+  links,
+  onChange,
+  getSuggestions,
+  data,
+  maxLinks,
+}: DataLinksInlineEditorProps) => {
   const theme = useTheme2();
   const [editIndex, setEditIndex] = useState<number | null>(null);
   const [isNew, setIsNew] = useState(false);
@@ -26,6 +34,7 @@ export const DataLinksInlineEditor = ({ links, onChange, getSuggestions, data }:
   const styles = getDataLinksInlineEditorStyles(theme);
   const linksSafe: DataLink[] = links ?? [];
   const isEditing = editIndex !== null;
+  const isAtLimit = !!maxLinks && maxLinks > 0 && linksSafe.length >= maxLinks;
 
   const onDataLinkChange = (index: number, link: DataLink) => {
     if (isNew) {
@@ -103,7 +112,14 @@ export const DataLinksInlineEditor = ({ links, onChange, getSuggestions, data }:
         </Modal>
       )}
 
-      <Button size="sm" icon="plus" onClick={onDataLinkAdd} variant="secondary">
+      <Button
+        size="sm"
+        icon="plus"
+        onClick={onDataLinkAdd}
+        variant="secondary"
+        disabled={isAtLimit}
+        tooltip={isAtLimit ? `A maximum of ${maxLinks} links is allowed` : undefined}
+      >
         Add link
       </Button>
     </>
@@ -2,7 +2,7 @@ import { screen, render, fireEvent } from '@testing-library/react';
 import React from 'react';
 import { useToggle } from 'react-use';
 
-import { LoadingState } from '@grafana/data';
+import { createTheme, LoadingState } from '@grafana/data';
 
 import { PanelChrome, PanelChromeProps } from './PanelChrome';
 
@@ -180,3 +180,35 @@ it('collapses the uncontrolled panel when user clicks on the chevron or the titl
   expect(button).not.toHaveAttribute('aria-controlls');
   expect(button.parentElement?.parentElement?.nextElementSibling?.id).toBe(undefined);
 });
+
+it('renders panel with a shadow instead of a border if borderStyle is shadow', () => {
+  setup({ borderStyle: 'shadow' });
+
+  const container = screen.getByTestId('Panel');
+  expect(container).toHaveStyle({ boxShadow: createTheme().shadows.z1 });
+  expect(container).toHaveStyle({ border: '1px solid transparent' });
+});
+
+it('renders panel without a border if borderStyle is none', () => {
+  setup({ borderStyle: 'none' });
+
+  expect(screen.getByTestId('Panel')).toHaveStyle({ border: '1px solid transparent' });
+});
+
+it('renders the description behind an info icon by default', () => {
+  setup({ title: 'Test Panel Header', description: 'Panel description' });
+
+  expect(screen.queryByTestId('panel-description-inline')).not.toBeInTheDocument();
+});
+
+it('renders the description inline if prop showDescription', () => {
+  setup({ title: 'Test Panel Header', description: 'Panel description', showDescription: true });
+
+  expect(screen.getByTestId('panel-description-inline')).toHaveTextContent('Panel description');
+});
+
+it('does not render an inline description if the description is empty', () => {
+  setup({ title: 'Test Panel Header', showDescription: true });
+
+  expect(screen.queryByTestId('panel-description-inline')).not.toBeInTheDocument();
+});
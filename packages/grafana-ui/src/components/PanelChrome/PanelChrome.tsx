@@ -27,6 +27,10 @@ interface BaseProps {
   padding?: PanelPadding;
   title?: string;
   description?: string | (() => string);
+  /**
+   * Show the description below the title instead of behind an info icon
+   */
+  showDescription?: boolean;
   titleItems?: ReactNode;
   menu?: ReactElement | (() => ReactElement);
   dragClass?: string;
@@ -50,6 +54,10 @@ interface BaseProps {
   leftItems?: ReactNode[];
   actions?: ReactNode;
   displayMode?: 'default' | 'transparent';
+  /**
+   * Border of the panel container, defaults to the theme's solid border
+   */
+  borderStyle?: PanelBorderStyle;
   onCancelQuery?: () => void;
   /**
    * callback when opening the panel menu
@@ -57,6 +65,8 @@ interface BaseProps {
   onOpenMenu?: () => void;
 }
 
+export type PanelBorderStyle = 'none' | 'solid' | 'shadow';
+
 interface FixedDimensions extends BaseProps {
   width: number;
   height: number;
@@ -103,7 +113,9 @@ export function PanelChrome({
   padding = 'md',
   title = '',
   description = '',
+  showDescription = false,
   displayMode = 'default',
+  borderStyle = 'solid',
   titleItems,
   menu,
   dragClass,
@@ -139,10 +151,12 @@ export function PanelChrome({
   const isPanelTransparent = displayMode === 'transparent';
 
   const headerHeight = getHeaderHeight(theme, hasHeader);
+  const showInlineDescription = showDescription && description !== '' && !collapsed;
+  const descriptionHeight = showInlineDescription ? theme.spacing.gridSize * 3 : 0;
   const { contentStyle, innerWidth, innerHeight } = getContentStyle(
     padding,
     theme,
-    headerHeight,
+    headerHeight + descriptionHeight,
     collapsed,
     height,
     width
@@ -198,7 +212,7 @@ export function PanelChrome({
       )}
 
       <div className={cx(styles.titleItems, dragClassCancel)} data-testid="title-items-container">
-        <PanelDescription description={description} className={dragClassCancel} />
+        {!showDescription && <PanelDescription description={description} className={dragClassCancel} />}
         {titleItems}
       </div>
       {loadingState === LoadingState.Streaming && (
@@ -230,7 +244,11 @@ export function PanelChrome({
   return (
     // tabIndex={0} is needed for keyboard accessibility in the plot area
     <div
-      className={cx(styles.container, { [styles.transparentContainer]: isPanelTransparent })}
+      className={cx(styles.container, {
+        [styles.borderlessContainer]: borderStyle === 'none',
+        [styles.shadowContainer]: borderStyle === 'shadow',
+        [styles.transparentContainer]: isPanelTransparent,
+      })}
       style={containerStyles}
       data-testid={testid}
       tabIndex={0} //eslint-disable-line jsx-a11y/no-noninteractive-tabindex
@@ -284,6 +302,10 @@ export function PanelChrome({
         </div>
       )}
 
+      {showInlineDescription && (
+        <PanelDescription inline description={description} className={cx(styles.inlineDescription, dragClassCancel)} />
+      )}
+
       {!collapsed && (
         <div
           id={panelContentId}
@@ -390,6 +412,15 @@ const getStyles = (theme: GrafanaTheme2) => {
         },
       },
     }),
+    borderlessContainer: css({
+      label: 'panel-borderless-container',
+      border: '1px solid transparent',
+    }),
+    shadowContainer: css({
+      label: 'panel-shadow-container',
+      border: '1px solid transparent',
+      boxShadow: theme.shadows.z1,
+    }),
     transparentContainer: css({
       label: 'panel-transparent-container',
       backgroundColor: 'transparent',
@@ -418,6 +449,13 @@ const getStyles = (theme: GrafanaTheme2) => {
       display: 'flex',
       alignItems: 'center',
     }),
+    inlineDescription: css({
+      label: 'panel-description',
+      flexShrink: 0,
+      height: theme.spacing.gridSize * 3,
+      lineHeight: `${theme.spacing.gridSize * 3}px`,
+      padding: theme.spacing(0, padding),
+    }),
     pointer: css({
       cursor: 'pointer',
     }),
@@ -44,6 +44,13 @@ export interface PanelContext {
   onAnnotationUpdate?: (annotation: AnnotationEventUIModel) => void;
   onAnnotationDelete?: (id: string) => void;
 
+  /**
+   * Start adding an annotation when a time range is selected, not only when Ctrl/Cmd is held
+   *
+   * @alpha -- experimental
+   */
+  annotateOnSelect?: boolean;
+
   /**
    * Used from visualizations like Table to add ad-hoc filters from cell values
    */
@@ -12,9 +12,13 @@ import { TitleItem } from './TitleItem';
 interface Props {
   description: string | (() => string);
   className?: string;
+  /**
+   * Render the description text in place instead of behind an info icon
+   */
+  inline?: boolean;
 }
 
-export function PanelDescription({ description, className }: Props) {
+export function PanelDescription({ description, className, inline = false }: Props) {
   const styles = useStyles2(getStyles);
 
   const getDescriptionContent = (): JSX.Element => {
@@ -28,13 +32,31 @@ export function PanelDescription({ description, className }: Props) {
     );
   };
 
-  return description !== '' ? (
+  if (description === '') {
+    return null;
+  }
+
+  if (inline) {
+    const panelDescription = typeof description === 'function' ? description() : description;
+
+    return (
+      <Tooltip interactive content={getDescriptionContent}>
+        <div
+          className={cx(className, styles.description, styles.inline)}
+          data-testid="panel-description-inline"
+          dangerouslySetInnerHTML={{ __html: panelDescription }}
+        />
+      </Tooltip>
+    );
+  }
+
+  return (
     <Tooltip interactive content={getDescriptionContent}>
       <TitleItem className={cx(className, styles.description)}>
         <Icon name="info-circle" size="md" />
       </TitleItem>
     </Tooltip>
-  ) : null;
+  );
 }
 
 const getStyles = (theme: GrafanaTheme2) => {
@@ -49,5 +71,18 @@ const getStyles = (theme: GrafanaTheme2) => {
         display: 'block',
       },
     }),
+    inline: css({
+      color: theme.colors.text.secondary,
+      fontSize: theme.typography.bodySmall.fontSize,
+      overflow: 'hidden',
+      textOverflow: 'ellipsis',
+      whiteSpace: 'nowrap',
+
+      // keep rendered markdown on a single line
+      '*': {
+        display: 'inline',
+        margin: 0,
+      },
+    }),
   };
 };
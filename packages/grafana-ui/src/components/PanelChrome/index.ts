@@ -7,7 +7,7 @@ import { TitleItem } from './TitleItem';
 /**
  * @internal
  */
-export type { PanelChromeProps, PanelPadding } from './PanelChrome';
+export type { PanelChromeProps, PanelPadding, PanelBorderStyle } from './PanelChrome';
 
 /**
  * @internal
@@ -131,6 +131,7 @@ export {
   PanelChrome,
   type PanelChromeProps,
   type PanelPadding,
+  type PanelBorderStyle,
   type PanelChromeType,
   PanelChromeLoadingIndicator,
   type PanelChromeLoadingIndicatorProps,
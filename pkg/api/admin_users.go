@@ -127,8 +127,9 @@ func (hs *HTTPServer) AdminUpdateUserPassword(c *contextmodel.ReqContext) respon
 	}
 
 	cmd := user.ChangeUserPasswordCommand{
-		UserID:      userID,
-		NewPassword: passwordHashed,
+		UserID:             userID,
+		NewPassword:        passwordHashed,
+		MustChangePassword: form.MustChangePassword,
 	}
 
 	if err := hs.userService.ChangePassword(c.Req.Context(), &cmd); err != nil {
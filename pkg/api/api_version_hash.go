@@ -0,0 +1,57 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/grafana/grafana/pkg/api/routing"
+	"github.com/grafana/grafana/pkg/web"
+)
+
+// apiVersionHashHeader is the response header API responses carry the API version hash in.
+const apiVersionHashHeader = "X-Grafana-Settings-Hash"
+
+// routeRecorder is a routing.Router that keeps track of the registered routes
+// before handing them to the wrapped router.
+type routeRecorder struct {
+	routing.Router
+	routes []string
+}
+
+func (r *routeRecorder) Handle(method, pattern string, handlers []web.Handler) {
+	r.routes = append(r.routes, method+" "+pattern)
+	r.Router.Handle(method, pattern, handlers)
+}
+
+func (r *routeRecorder) Get(pattern string, handlers ...web.Handler) {
+	r.routes = append(r.routes, http.MethodGet+" "+pattern)
+	r.Router.Get(pattern, handlers...)
+}
+
+// computeAPIVersionHash returns a hash of the backend version and the registered routes.
+// Routes are sorted first, so replicas built from the same commit get the same hash
+// regardless of the order the routes were registered in.
+func computeAPIVersionHash(version string, routes []string) string {
+	sorted := make([]string, len(routes))
+	copy(sorted, routes)
+	sort.Strings(sorted)
+
+	h := sha256.New()
+	h.Write([]byte(version))
+	for _, r := range sorted {
+		h.Write([]byte("\n" + r))
+	}
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// apiVersionHashHandler adds the API version hash to API responses so that a frontend
+// loaded from a different backend version can detect the skew and prompt for a reload.
+func (hs *HTTPServer) apiVersionHashHandler(ctx *web.Context) {
+	if hs.apiVersionHash == "" || !strings.HasPrefix(ctx.Req.URL.Path, "/api/") {
+		return
+	}
+	ctx.Resp.Header().Set(apiVersionHashHeader, hs.apiVersionHash)
+}
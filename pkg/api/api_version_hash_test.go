@@ -0,0 +1,72 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana/pkg/api/routing"
+	"github.com/grafana/grafana/pkg/web"
+)
+
+func TestComputeAPIVersionHash(t *testing.T) {
+	handler := func(c *web.Context) {}
+
+	recordRoutes := func(t *testing.T, register func(rr routing.RouteRegister)) []string {
+		t.Helper()
+		rr := routing.NewRouteRegister()
+		register(rr)
+		recorder := &routeRecorder{Router: web.New()}
+		rr.Register(recorder)
+		return recorder.routes
+	}
+
+	first := recordRoutes(t, func(rr routing.RouteRegister) {
+		rr.Get("/api/dashboards", handler)
+		rr.Group("/api/folders", func(folders routing.RouteRegister) {
+			folders.Post("/", handler)
+			folders.Delete("/:uid", handler)
+		})
+	})
+	second := recordRoutes(t, func(rr routing.RouteRegister) {
+		rr.Group("/api/folders", func(folders routing.RouteRegister) {
+			folders.Delete("/:uid", handler)
+			folders.Post("/", handler)
+		})
+		rr.Get("/api/dashboards", handler)
+	})
+	require.Len(t, first, 3)
+	require.NotEqual(t, first, second)
+
+	t.Run("routes registered in a different order produce the same hash", func(t *testing.T) {
+		assert.Equal(t, computeAPIVersionHash("10.2.0", first), computeAPIVersionHash("10.2.0", second))
+	})
+
+	t.Run("a different version produces a different hash", func(t *testing.T) {
+		assert.NotEqual(t, computeAPIVersionHash("10.2.0", first), computeAPIVersionHash("10.2.1", first))
+	})
+
+	t.Run("a different set of routes produces a different hash", func(t *testing.T) {
+		assert.NotEqual(t, computeAPIVersionHash("10.2.0", first), computeAPIVersionHash("10.2.0", first[1:]))
+	})
+}
+
+func TestHTTPServer_apiVersionHashHandler(t *testing.T) {
+	hs := &HTTPServer{apiVersionHash: "0123456789abcdef"}
+
+	m := web.New()
+	m.Use(hs.apiVersionHashHandler)
+	m.Get("/api/health", func(c *web.Context) { c.Resp.WriteHeader(http.StatusOK) })
+	m.Get("/public/build/app.js", func(c *web.Context) { c.Resp.WriteHeader(http.StatusOK) })
+
+	rec := httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/health", nil))
+	assert.Equal(t, "0123456789abcdef", rec.Header().Get(apiVersionHashHeader))
+
+	rec = httptest.NewRecorder()
+	m.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/public/build/app.js", nil))
+	assert.Empty(t, rec.Header().Get(apiVersionHashHeader))
+}
@@ -7,7 +7,6 @@
 package avatar
 
 import (
-	"bufio"
 	"bytes"
 	"fmt"
 	"io"
@@ -17,6 +16,7 @@ import (
 	"path/filepath"
 	"regexp"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -30,15 +30,22 @@ import (
 
 const (
 	gravatarSource = "https://secure.gravatar.com/avatar/"
+	// maxAvatarSize is the largest image accepted from gravatar or a custom avatar source
+	maxAvatarSize = 1 << 20
+	// defaultContentType is used for avatars whose content type couldn't be detected
+	defaultContentType = "image/jpeg"
 )
 
 // Avatar represents the avatar object.
 type Avatar struct {
-	hash      string
-	data      *bytes.Buffer
-	notFound  bool
-	isCustom  bool
-	timestamp time.Time
+	hash        string
+	data        *bytes.Buffer
+	contentType string
+	notFound    bool
+	isCustom    bool
+	// fromSource is set for avatars fetched from a custom avatar source instead of gravatar
+	fromSource bool
+	timestamp  time.Time
 }
 
 var (
@@ -73,6 +80,9 @@ func (a *Avatar) Encode(wr io.Writer) error {
 
 func (a *Avatar) update(baseUrl string) (err error) {
 	customUrl := baseUrl + a.hash + "?"
+	if a.fromSource {
+		customUrl = setting.AvatarSourceURLForHash(baseUrl, a.hash)
+	}
 	select {
 	case <-time.After(time.Second * 3):
 		err = fmt.Errorf("get gravatar image %s timeout", a.hash)
@@ -109,7 +119,12 @@ func (a *AvatarCacheServer) Handler(ctx *contextmodel.ReqContext) {
 
 	avatar := a.GetAvatarForHash(hash)
 
-	ctx.Resp.Header().Set("Content-Type", "image/jpeg")
+	contentType := avatar.contentType
+	if !strings.HasPrefix(contentType, "image/") {
+		contentType = defaultContentType
+	}
+	ctx.Resp.Header().Set("Content-Type", contentType)
+	ctx.Resp.Header().Set("X-Content-Type-Options", "nosniff")
 
 	if !a.cfg.EnableGzip {
 		ctx.Resp.Header().Set("Content-Length", strconv.Itoa(len(avatar.data.Bytes())))
@@ -123,21 +138,39 @@ func (a *AvatarCacheServer) Handler(ctx *contextmodel.ReqContext) {
 	}
 }
 
+// GetAvatarForHash returns the avatar from the custom avatar source if one is configured,
+// otherwise from gravatar. The default profile image is returned if neither is available.
 func (a *AvatarCacheServer) GetAvatarForHash(hash string) *Avatar {
+	if setting.AvatarSourceURL != "" {
+		return a.getAvatarFromSource(hash, setting.AvatarSourceURL)
+	}
 	if setting.DisableGravatar {
-		alog.Warn("'GetGravatarForHash' called despite gravatars being disabled; returning default profile image")
+		alog.Debug("Gravatar is disabled and no custom avatar source is configured; returning default profile image")
 		return a.notFound
 	}
 	return a.getAvatarForHash(hash, gravatarSource)
 }
 
 func (a *AvatarCacheServer) getAvatarForHash(hash string, baseUrl string) *Avatar {
+	return a.getAvatar(hash, baseUrl, false)
+}
+
+func (a *AvatarCacheServer) getAvatarFromSource(hash string, source string) *Avatar {
+	return a.getAvatar(hash, source, true)
+}
+
+func (a *AvatarCacheServer) getAvatar(hash string, baseUrl string, fromSource bool) *Avatar {
 	var avatar *Avatar
 	obj, exists := a.cache.Get(hash)
 	if exists {
 		avatar = obj.(*Avatar)
-	} else {
+	}
+	if avatar == nil || avatar.fromSource != fromSource {
+		// the avatar source changed since the avatar was cached
 		avatar = New(hash)
+		avatar.fromSource = fromSource
+		exists = false
+		a.cache.Delete(hash)
 	}
 
 	if avatar.Expired() {
@@ -177,8 +210,9 @@ func newCacheServer(cfg *setting.Cfg) *AvatarCacheServer {
 
 func newNotFound(cfg *setting.Cfg) *Avatar {
 	avatar := &Avatar{
-		notFound: true,
-		isCustom: false,
+		contentType: "image/png",
+		notFound:    true,
+		isCustom:    false,
 	}
 
 	// load user_profile png into buffer
@@ -267,6 +301,10 @@ func (a *thunderTask) fetch() error {
 	a.Avatar.timestamp = time.Now()
 
 	alog.Debug("avatar.fetch(fetch new avatar)", "url", a.BaseUrl)
+	if a.Avatar.fromSource {
+		return performGet(a.BaseUrl, a.Avatar, getSourceAvatarHandler)
+	}
+
 	// First do the fetch to get the Gravatar with a retro icon fallback
 	err := performGet(a.BaseUrl+gravatarReqParams, a.Avatar, getGravatarHandler)
 
@@ -286,11 +324,41 @@ func getGravatarHandler(av *Avatar, resp *http.Response) error {
 		return fmt.Errorf("status code: %d", resp.StatusCode)
 	}
 
-	av.data = &bytes.Buffer{}
-	writer := bufio.NewWriter(av.data)
+	if resp.ContentLength > maxAvatarSize {
+		av.setAvatarNotFound()
+		return fmt.Errorf("avatar is larger than %d bytes", maxAvatarSize)
+	}
 
-	_, err := io.Copy(writer, resp.Body)
-	return err
+	data := &bytes.Buffer{}
+	n, err := data.ReadFrom(io.LimitReader(resp.Body, maxAvatarSize+1))
+	if err != nil {
+		av.setAvatarNotFound()
+		return err
+	}
+	if n > maxAvatarSize {
+		av.setAvatarNotFound()
+		return fmt.Errorf("avatar is larger than %d bytes", maxAvatarSize)
+	}
+
+	av.data = data
+	av.contentType = http.DetectContentType(data.Bytes())
+	return nil
+}
+
+// Stores the image from a custom avatar source, anything that isn't a raster image is rejected
+// since the proxy serves it from the Grafana origin.
+func getSourceAvatarHandler(av *Avatar, resp *http.Response) error {
+	if err := getGravatarHandler(av, resp); err != nil {
+		return err
+	}
+
+	if !strings.HasPrefix(av.contentType, "image/") {
+		av.setAvatarNotFound()
+		return fmt.Errorf("unsupported avatar content type %q", av.contentType)
+	}
+
+	av.isCustom = true
+	return nil
 }
 
 // Uses the d=404 fallback to see if the gravatar we got back is custom
@@ -1,6 +1,7 @@
 package avatar
 
 import (
+	"bytes"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -9,13 +10,16 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	contextmodel "github.com/grafana/grafana/pkg/services/contexthandler/model"
 	"github.com/grafana/grafana/pkg/setting"
+	"github.com/grafana/grafana/pkg/web"
 )
 
 const DEFAULT_NONSENSE_HASH string = "9e107d9d372bb6826bd81d3542a419d6"
 const CUSTOM_NONSENSE_HASH string = "d2a9116d4a63304733ca0f3471e57d16"
 
 var NONSENSE_BODY []byte = []byte("Bogus API response")
+var PNG_BODY []byte = []byte("\x89PNG\r\n\x1a\nnot really a png")
 
 func TestAvatar_AvatarRetrieval(t *testing.T) {
 	avc := ProvideAvatarCacheServer(setting.NewCfg())
@@ -97,6 +101,98 @@ func TestAvatar_ExpirationHandler(t *testing.T) {
 	require.Equal(t, callCounter, 4)
 }
 
+func TestAvatar_CustomSource(t *testing.T) {
+	avc := ProvideAvatarCacheServer(setting.NewCfg())
+	var requested []string
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = append(requested, r.URL.Path)
+		switch r.URL.Path {
+		case "/api/" + DEFAULT_NONSENSE_HASH + ".png":
+			_, _ = w.Write(PNG_BODY)
+		case "/api/" + CUSTOM_NONSENSE_HASH + ".png":
+			_, _ = w.Write([]byte("<svg xmlns=\"http://www.w3.org/2000/svg\"><script>alert(1)</script></svg>"))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+
+	t.Cleanup(func() {
+		avc.cache.Flush()
+		mockServer.Close()
+	})
+
+	t.Run("fetches the avatar from the source template", func(t *testing.T) {
+		av := avc.getAvatarFromSource(DEFAULT_NONSENSE_HASH, mockServer.URL+"/api/{hash}.png")
+		require.Equal(t, []string{"/api/" + DEFAULT_NONSENSE_HASH + ".png"}, requested)
+		require.False(t, av.notFound)
+		require.True(t, av.isCustom)
+		require.Equal(t, PNG_BODY, av.data.Bytes())
+		require.Equal(t, "image/png", av.contentType)
+	})
+
+	t.Run("rejects content that isn't an image", func(t *testing.T) {
+		av := avc.getAvatarFromSource(CUSTOM_NONSENSE_HASH, mockServer.URL+"/api/{hash}.png")
+		require.Equal(t, avc.notFound, av)
+	})
+
+	t.Run("refetches gravatar avatars cached before the source was configured", func(t *testing.T) {
+		gravatarCounter := 0
+		gravatarServer := setupMockGravatarServer(&gravatarCounter, false)
+		t.Cleanup(gravatarServer.Close)
+
+		avc.cache.Flush()
+		requested = nil
+		avc.getAvatarForHash(DEFAULT_NONSENSE_HASH, gravatarServer.URL+"/avatar/")
+		av := avc.getAvatarFromSource(DEFAULT_NONSENSE_HASH, mockServer.URL+"/api/{hash}.png")
+		require.Len(t, requested, 1)
+		require.Equal(t, PNG_BODY, av.data.Bytes())
+	})
+}
+
+func TestAvatar_OversizedResponse(t *testing.T) {
+	avc := ProvideAvatarCacheServer(setting.NewCfg())
+	mockServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// no Content-Length is sent for chunked responses, so the body itself has to be limited
+		w.Header().Set("Transfer-Encoding", "chunked")
+		_, _ = w.Write(PNG_BODY)
+		_, _ = w.Write(bytes.Repeat([]byte{0}, maxAvatarSize))
+	}))
+
+	t.Cleanup(func() {
+		avc.cache.Flush()
+		mockServer.Close()
+	})
+
+	av := avc.getAvatarFromSource(DEFAULT_NONSENSE_HASH, mockServer.URL+"/{hash}")
+	require.Equal(t, avc.notFound, av)
+
+	av = avc.getAvatarForHash(CUSTOM_NONSENSE_HASH, mockServer.URL+"/avatar/")
+	require.Equal(t, avc.notFound, av)
+}
+
+func TestAvatar_DisabledGravatar(t *testing.T) {
+	disableGravatar, avatarSourceURL := setting.DisableGravatar, setting.AvatarSourceURL
+	setting.DisableGravatar, setting.AvatarSourceURL = true, ""
+	avc := ProvideAvatarCacheServer(setting.NewCfg())
+	notFound := avc.notFound
+	avc.notFound = &Avatar{data: bytes.NewBuffer(PNG_BODY), contentType: "image/png", notFound: true}
+
+	t.Cleanup(func() {
+		setting.DisableGravatar, setting.AvatarSourceURL = disableGravatar, avatarSourceURL
+		avc.notFound = notFound
+		avc.cache.Flush()
+	})
+
+	req := web.SetURLParams(httptest.NewRequest(http.MethodGet, "/avatar/"+DEFAULT_NONSENSE_HASH, nil), map[string]string{":hash": DEFAULT_NONSENSE_HASH})
+	rec := httptest.NewRecorder()
+	avc.Handler(&contextmodel.ReqContext{Context: &web.Context{Req: req, Resp: web.NewResponseWriter(http.MethodGet, rec)}})
+
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, PNG_BODY, rec.Body.Bytes())
+	require.Equal(t, "image/png", rec.Header().Get("Content-Type"))
+	require.Equal(t, "private, max-age=3600", rec.Header().Get("Cache-Control"))
+}
+
 func setupMockGravatarServer(counter *int, simulateError bool) *httptest.Server {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		(*counter)++
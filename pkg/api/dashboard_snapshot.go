@@ -141,6 +141,16 @@ func (hs *HTTPServer) CreateDashboardSnapshot(c *contextmodel.ReqContext) respon
 			return nil
 		}
 
+		limitReached, err := hs.externalSnapshotLimitReached(c)
+		if err != nil {
+			c.JsonApiErr(http.StatusInternalServerError, "Failed to count external snapshots", err)
+			return nil
+		}
+		if limitReached {
+			c.JsonApiErr(http.StatusForbidden, "External snapshot limit reached", nil)
+			return nil
+		}
+
 		resp, err := createExternalDashboardSnapshot(cmd, hs.Cfg.ExternalSnapshotUrl)
 		if err != nil {
 			c.JsonApiErr(http.StatusInternalServerError, "Failed to create external snapshot", err)
@@ -197,6 +207,29 @@ func (hs *HTTPServer) CreateDashboardSnapshot(c *contextmodel.ReqContext) respon
 	return nil
 }
 
+// externalSnapshotLimitReached counts the external snapshots the signed in user can see, like the share dialog does.
+func (hs *HTTPServer) externalSnapshotLimitReached(c *contextmodel.ReqContext) (bool, error) {
+	if hs.Cfg.MaxExternalSnapshots <= 0 {
+		return false, nil
+	}
+
+	snapshots, err := hs.dashboardsnapshotsService.SearchDashboardSnapshots(c.Req.Context(), &dashboardsnapshots.GetDashboardSnapshotsQuery{
+		OrgID:        c.SignedInUser.GetOrgID(),
+		SignedInUser: c.SignedInUser,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	external := 0
+	for _, snapshot := range snapshots {
+		if snapshot.External {
+			external++
+		}
+	}
+	return external >= hs.Cfg.MaxExternalSnapshots, nil
+}
+
 // GET /api/snapshots/:key
 // swagger:route GET /snapshots/{key} snapshots getDashboardSnapshot
 //
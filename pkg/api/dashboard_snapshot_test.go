@@ -123,6 +123,64 @@ func TestHTTPServer_DeleteDashboardSnapshot(t *testing.T) {
 	})
 }
 
+func TestHTTPServer_CreateDashboardSnapshot_ExternalLimit(t *testing.T) {
+	externalServer := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Header().Set("Content-Type", "application/json")
+		_, err := rw.Write([]byte(`{"key":"abc","deleteKey":"def","url":"http://external/dashboard/snapshot/abc","deleteUrl":"http://external/api/snapshots-delete/def"}`))
+		require.NoError(t, err)
+	}))
+	t.Cleanup(externalServer.Close)
+
+	setup := func(t *testing.T, externalSnapshots int) (*webtest.Server, *dashboardsnapshots.MockService) {
+		t.Helper()
+
+		snapshots := dashboardsnapshots.DashboardSnapshotsList{{ID: 1}}
+		for i := 0; i < externalSnapshots; i++ {
+			snapshots = append(snapshots, &dashboardsnapshots.DashboardSnapshotDTO{ID: int64(i + 2), External: true})
+		}
+
+		dashSnapSvc := dashboardsnapshots.NewMockService(t)
+		dashSnapSvc.On("SearchDashboardSnapshots", mock.Anything, mock.AnythingOfType("*dashboardsnapshots.GetDashboardSnapshotsQuery")).Return(snapshots, nil)
+		dashSnapSvc.On("CreateDashboardSnapshot", mock.Anything, mock.AnythingOfType("*dashboardsnapshots.CreateDashboardSnapshotCommand")).Return(&dashboardsnapshots.DashboardSnapshot{ID: 10}, nil).Maybe()
+
+		server := SetupAPITestServer(t, func(hs *HTTPServer) {
+			cfg := setting.NewCfg()
+			cfg.SnapshotEnabled = true
+			cfg.ExternalEnabled = true
+			cfg.ExternalSnapshotUrl = externalServer.URL
+			cfg.MaxExternalSnapshots = 2
+			hs.Cfg = cfg
+			hs.dashboardsnapshotsService = dashSnapSvc
+		})
+		return server, dashSnapSvc
+	}
+
+	createExternalSnapshot := func(t *testing.T, server *webtest.Server) int {
+		t.Helper()
+
+		req := server.NewRequest(http.MethodPost, "/api/snapshots/", strings.NewReader(`{"external":true,"dashboard":{"uid":"abc"}}`))
+		req.Header.Set("Content-Type", "application/json")
+		res, err := server.Send(webtest.RequestWithSignedInUser(req, &user.SignedInUser{UserID: 1, OrgID: 1, OrgRole: org.RoleEditor}))
+		require.NoError(t, err)
+		require.NoError(t, res.Body.Close())
+		return res.StatusCode
+	}
+
+	t.Run("User should be able to create an external snapshot below the limit", func(t *testing.T) {
+		server, dashSnapSvc := setup(t, 1)
+
+		assert.Equal(t, http.StatusOK, createExternalSnapshot(t, server))
+		dashSnapSvc.AssertCalled(t, "CreateDashboardSnapshot", mock.Anything, mock.Anything)
+	})
+
+	t.Run("User should not be able to create an external snapshot once the limit is reached", func(t *testing.T) {
+		server, dashSnapSvc := setup(t, 2)
+
+		assert.Equal(t, http.StatusForbidden, createExternalSnapshot(t, server))
+		dashSnapSvc.AssertNotCalled(t, "CreateDashboardSnapshot", mock.Anything, mock.Anything)
+	})
+}
+
 func TestDashboardSnapshotAPIEndpoint_singleSnapshot(t *testing.T) {
 	setupRemoteServer := func(fn func(http.ResponseWriter, *http.Request)) *httptest.Server {
 		s := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
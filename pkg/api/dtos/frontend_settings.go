@@ -1,6 +1,8 @@
 package dtos
 
 import (
+	"encoding/json"
+
 	"github.com/grafana/grafana/pkg/plugins"
 	"github.com/grafana/grafana/pkg/setting"
 )
@@ -29,6 +31,11 @@ type FrontendSettingsBuildInfoDTO struct {
 	LatestVersion string `json:"latestVersion"`
 	HasUpdate     bool   `json:"hasUpdate"`
 	Env           string `json:"env"`
+
+	// DegradedMode is set when one or more subsystems failed their health
+	// probe; DegradedReasons describes which.
+	DegradedMode    bool     `json:"degradedMode"`
+	DegradedReasons []string `json:"degradedReasons"`
 }
 
 type FrontendSettingsLicenseInfoDTO struct {
@@ -70,6 +77,8 @@ type FrontendSettingsUnifiedAlertingDTO struct {
 	MinInterval              string `json:"minInterval"`
 	AlertStateHistoryBackend string `json:"alertStateHistoryBackend,omitempty"`
 	AlertStateHistoryPrimary string `json:"alertStateHistoryPrimary,omitempty"`
+	DefaultAlertContactPoint string `json:"defaultAlertContactPoint,omitempty"`
+	MaxRulesPerGroup         int    `json:"maxRulesPerGroup,omitempty"`
 }
 
 // Enterprise-only
@@ -93,7 +102,21 @@ type FrontendSettingsFooterConfigItemDTO struct {
 	Text   string `json:"text"`
 	Url    string `json:"url"`
 	Icon   string `json:"icon"`
-	Target string `json:"blank"`
+	Target string `json:"target"`
+}
+
+// MarshalJSON also writes the target under the legacy "blank" key so that
+// frontends reading the old key keep working. The "blank" key will be removed
+// in the next release.
+func (item FrontendSettingsFooterConfigItemDTO) MarshalJSON() ([]byte, error) {
+	type footerConfigItem FrontendSettingsFooterConfigItemDTO
+	return json.Marshal(struct {
+		footerConfigItem
+		Blank string `json:"blank"`
+	}{
+		footerConfigItem: footerConfigItem(item),
+		Blank:            item.Target,
+	})
 }
 
 // Enterprise-only
@@ -120,12 +143,40 @@ type FrontendSettingsWhitelabelingDTO struct {
 	PublicDashboard    *FrontendSettingsPublicDashboardConfigDTO `json:"publicDashboard,omitempty"`
 }
 
+type FrontendSettingsNavigationDTO struct {
+	HiddenSections []string `json:"hiddenSections"`
+	PinnedItems    []string `json:"pinnedItems"`
+}
+
+type FrontendSettingsRenderingDTO struct {
+	Available             bool     `json:"available"`
+	Version               string   `json:"version"`
+	SupportedFormats      []string `json:"supportedFormats"`
+	ConcurrencyLimit      int      `json:"concurrencyLimit"`
+	DefaultTimeoutSeconds int      `json:"defaultTimeoutSeconds"`
+}
+
+type FrontendSettingsAvatarsDTO struct {
+	GravatarEnabled  bool   `json:"gravatarEnabled"`
+	DefaultAvatarUrl string `json:"defaultAvatarUrl"`
+	CustomSourceUrl  string `json:"customSourceUrl"`
+}
+
 type FrontendSettingsSqlConnectionLimitsDTO struct {
 	MaxOpenConns    int `json:"maxOpenConns"`
 	MaxIdleConns    int `json:"maxIdleConns"`
 	ConnMaxLifetime int `json:"connMaxLifetime"`
 }
 
+type FrontendSettingsSecretsManagerDTO struct {
+	Enabled bool `json:"enabled"`
+
+	// Only returned to server admins
+	PluginID                      string `json:"pluginId,omitempty"`
+	MigrationStatus               string `json:"migrationStatus,omitempty"`
+	BackwardsCompatibilityEnabled *bool  `json:"backwardsCompatibilityEnabled,omitempty"`
+}
+
 type FrontendSettingsDTO struct {
 	DefaultDatasource          string                           `json:"defaultDatasource"`
 	Datasources                map[string]plugins.DataSourceDTO `json:"datasources"`
@@ -183,6 +234,62 @@ type FrontendSettingsDTO struct {
 	TrustedTypesDefaultPolicyEnabled    bool     `json:"trustedTypesDefaultPolicyEnabled"`
 	CSPReportOnlyEnabled                bool     `json:"cspReportOnlyEnabled"`
 	DisableFrontendSandboxForPlugins    []string `json:"disableFrontendSandboxForPlugins"`
+	DefaultLogsSortOrder                string   `json:"defaultLogsSortOrder"`
+	ApiVersionHash                      string   `json:"apiVersionHash"`
+	AngularPlugins                      []string `json:"angularPlugins"`
+	ProvisioningReloadIntervalSeconds   int      `json:"provisioningReloadIntervalSeconds"`
+	AutoLogoutOnIdle                    bool     `json:"autoLogoutOnIdle"`
+	IdleTimeoutSeconds                  int      `json:"idleTimeoutSeconds"`
+	SignoutRedirectUrl                  string   `json:"signoutRedirectUrl"`
+	SmtpEnabled                         bool     `json:"smtpEnabled"`
+	ResetPasswordEnabled                bool     `json:"resetPasswordEnabled"`
+	MaxSearchResults                    int      `json:"maxSearchResults"`
+	MustChangePassword                  bool     `json:"mustChangePassword"`
+	TeamPickerPageSize                  int      `json:"teamPickerPageSize"`
+	ServerTimeMillis                    int64    `json:"serverTimeMillis"`
+	ServerTimezone                      string   `json:"serverTimezone"`
+	Namespace                           string   `json:"namespace"`
+	OrgId                               int64    `json:"orgId"`
+	OrgName                             string   `json:"orgName"`
+	UnifiedStorageEnabled               bool     `json:"unifiedStorageEnabled"`
+	MaxConcurrentTransformations        int      `json:"maxConcurrentTransformations"`
+	DefaultTextPanelContent             string   `json:"defaultTextPanelContent"`
+	MaxTemplateVariables                int      `json:"maxTemplateVariables"`
+	MaxDashboardPanels                  int      `json:"maxDashboardPanels"`
+	MaxPanelLinks                       int      `json:"maxPanelLinks"`
+	PauseRefreshWhileEditing            bool     `json:"pauseRefreshWhileEditing"`
+	MaxDatasourceConnections            int      `json:"maxDatasourceConnections"`
+	DefaultReduceCalc                   string   `json:"defaultReduceCalc"`
+	MaxEmbedRequestsPerMinute           int      `json:"maxEmbedRequestsPerMinute"`
+	MaxSessionQueryHistory              int      `json:"maxSessionQueryHistory"`
+	MaxExternalSnapshots                int      `json:"maxExternalSnapshots"`
+	DefaultPanelMinInterval             string   `json:"defaultPanelMinInterval"`
+	MaxConcurrentAnnotationQueries      int      `json:"maxConcurrentAnnotationQueries"`
+	DefaultPanelBorderStyle             string   `json:"defaultPanelBorderStyle"`
+	MinVariableRefreshIntervalSeconds   int      `json:"minVariableRefreshIntervalSeconds"`
+	DefaultAllValue                     string   `json:"defaultAllValue"`
+	MaxDashboardRenderQueue             int      `json:"maxDashboardRenderQueue"`
+	DefaultFillOpacity                  int      `json:"defaultFillOpacity"`
+	MaxFolderTreeNodes                  int      `json:"maxFolderTreeNodes"`
+	DefaultDashboardLinkIcon            string   `json:"defaultDashboardLinkIcon"`
+	DefaultDashboardLinkTooltip         string   `json:"defaultDashboardLinkTooltip"`
+	MaxInlineSVGBytes                   int      `json:"maxInlineSVGBytes"`
+	DefaultExploreRangeSelectAction     string   `json:"defaultExploreRangeSelectAction"`
+	DefaultShowPanelDescription         bool     `json:"defaultShowPanelDescription"`
+	MaxBreadcrumbDepth                  int      `json:"maxBreadcrumbDepth"`
+	DefaultDatasourceVariableFilter     string   `json:"defaultDatasourceVariableFilter"`
+	MaxDashboardFields                  int      `json:"maxDashboardFields"`
+	DefaultExploreSupplementaryQueries  bool     `json:"defaultExploreSupplementaryQueries"`
+	MaxVariablesPerQuery                int      `json:"maxVariablesPerQuery"`
+	DefaultTimeShift                    string   `json:"defaultTimeShift"`
+	DefaultRepeatScope                  string   `json:"defaultRepeatScope"`
+	MaxSearchQueryLength                int      `json:"maxSearchQueryLength"`
+	MaxDatasourceInstancesPerPlugin     int      `json:"maxDatasourceInstancesPerPlugin"`
+	MaxRenderedAnnotations              int      `json:"maxRenderedAnnotations"`
+	DefaultVariableMultiSelect          bool     `json:"defaultVariableMultiSelect"`
+	MaxRefreshesBeforePause             int      `json:"maxRefreshesBeforePause"`
+
+	ApplicationInsightsSamplingPercentage float64 `json:"applicationInsightsSamplingPercentage"`
 
 	Auth FrontendSettingsAuthDTO `json:"auth"`
 
@@ -224,16 +331,37 @@ type FrontendSettingsDTO struct {
 	GeomapDefaultBaseLayerConfig *map[string]any `json:"geomapDefaultBaseLayerConfig,omitempty"`
 	GeomapDisableCustomBaseLayer bool            `json:"geomapDisableCustomBaseLayer"`
 
+	DefaultThresholdSteps       []map[string]any  `json:"defaultThresholdSteps,omitempty"`
+	DefaultSpecialValueMappings []map[string]any  `json:"defaultSpecialValueMappings,omitempty"`
+	LogLevelColorMap            map[string]string `json:"logLevelColorMap,omitempty"`
+
+	DefaultExploreVizByDatasourceType map[string]string `json:"defaultExploreVizByDatasourceType"`
+	ExploreRowLimitByType             map[string]int    `json:"exploreRowLimitByType"`
+
 	PublicDashboardAccessToken string `json:"publicDashboardAccessToken"`
 
 	DateFormats setting.DateFormats `json:"dateFormats,omitempty"`
 
+	AvailableLocales []string `json:"availableLocales"`
+	DefaultLocale    string   `json:"defaultLocale"`
+
 	LoginError string `json:"loginError,omitempty"`
 
 	PluginsCDNBaseURL string `json:"pluginsCDNBaseURL,omitempty"`
 
 	SqlConnectionLimits FrontendSettingsSqlConnectionLimitsDTO `json:"sqlConnectionLimits"`
 
+	SecretsManager FrontendSettingsSecretsManagerDTO `json:"secretsManager"`
+
+	Navigation FrontendSettingsNavigationDTO `json:"navigation"`
+
+	Avatars FrontendSettingsAvatarsDTO `json:"avatars"`
+
+	Rendering FrontendSettingsRenderingDTO `json:"rendering"`
+
+	// Extensions holds the settings registered by plugins, keyed by namespace.
+	Extensions map[string]json.RawMessage `json:"extensions"`
+
 	// Enterprise
 	Licensing     *FrontendSettingsLicensingDTO     `json:"licensing,omitempty"`
 	Whitelabeling *FrontendSettingsWhitelabelingDTO `json:"whitelabeling,omitempty"`
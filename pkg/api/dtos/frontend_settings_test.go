@@ -0,0 +1,29 @@
+package dtos
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFrontendSettingsFooterConfigItemDTO_MarshalJSON(t *testing.T) {
+	item := FrontendSettingsFooterConfigItemDTO{
+		Text:   "Support",
+		Url:    "https://support.example.com",
+		Icon:   "question-circle",
+		Target: "_blank",
+	}
+
+	data, err := json.Marshal(item)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{
+		"text": "Support",
+		"url": "https://support.example.com",
+		"icon": "question-circle",
+		"target": "_blank",
+		"blank": "_blank"
+	}`, string(data))
+}
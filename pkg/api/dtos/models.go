@@ -109,7 +109,7 @@ func (mr *MetricRequest) CloneWithQueries(queries []*simplejson.Json) MetricRequ
 }
 
 func GetGravatarUrl(text string) string {
-	if setting.DisableGravatar {
+	if !setting.AvatarProxyEnabled() {
 		return setting.AppSubUrl + "/public/img/user_profile.png"
 	}
 
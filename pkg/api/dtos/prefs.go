@@ -18,6 +18,8 @@ type UpdatePrefsCmd struct {
 	QueryHistory *pref.QueryHistoryPreference `json:"queryHistory,omitempty"`
 	Language     string                       `json:"language"`
 	Cookies      []pref.CookieType            `json:"cookies,omitempty"`
+	// UID of the data source used by default, only supported for users and teams
+	DefaultDatasourceUID string `json:"defaultDatasourceUID,omitempty"`
 }
 
 // swagger:model
@@ -34,4 +36,6 @@ type PatchPrefsCmd struct {
 	QueryHistory     *pref.QueryHistoryPreference `json:"queryHistory,omitempty"`
 	HomeDashboardUID *string                      `json:"homeDashboardUID,omitempty"`
 	Cookies          []pref.CookieType            `json:"cookies,omitempty"`
+	// UID of the data source used by default, only supported for users and teams
+	DefaultDatasourceUID *string `json:"defaultDatasourceUID,omitempty"`
 }
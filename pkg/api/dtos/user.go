@@ -22,7 +22,8 @@ type AdminCreateUserForm struct {
 }
 
 type AdminUpdateUserPasswordForm struct {
-	Password string `json:"password" binding:"Required"`
+	Password           string `json:"password" binding:"Required"`
+	MustChangePassword bool   `json:"mustChangePassword"`
 }
 
 type AdminUpdateUserPermissionsForm struct {
@@ -2,26 +2,44 @@ package api
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"net/http"
+	"os"
+	"path/filepath"
 	"slices"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/grafana/grafana/pkg/api/dtos"
+	"github.com/grafana/grafana/pkg/infra/log"
 	"github.com/grafana/grafana/pkg/plugins"
 	"github.com/grafana/grafana/pkg/services/accesscontrol"
+	"github.com/grafana/grafana/pkg/services/auth/identity"
 	contextmodel "github.com/grafana/grafana/pkg/services/contexthandler/model"
 	"github.com/grafana/grafana/pkg/services/datasources"
 	"github.com/grafana/grafana/pkg/services/featuremgmt"
+	"github.com/grafana/grafana/pkg/services/grafana-apiserver/endpoints/request"
 	"github.com/grafana/grafana/pkg/services/licensing"
+	"github.com/grafana/grafana/pkg/services/navtree"
+	"github.com/grafana/grafana/pkg/services/org"
 	"github.com/grafana/grafana/pkg/services/pluginsintegration/pluginsettings"
 	"github.com/grafana/grafana/pkg/services/pluginsintegration/pluginstore"
+	pref "github.com/grafana/grafana/pkg/services/preference"
+	"github.com/grafana/grafana/pkg/services/rendering"
 	"github.com/grafana/grafana/pkg/services/secrets/kvstore"
 	"github.com/grafana/grafana/pkg/setting"
 	"github.com/grafana/grafana/pkg/tsdb/grafanads"
 	"github.com/grafana/grafana/pkg/util"
 )
 
+const (
+	fallbackLocale = "en-US"
+	pseudoLocale   = "pseudo-LOCALE"
+)
+
 func (hs *HTTPServer) GetFrontendSettings(c *contextmodel.ReqContext) {
 	settings, err := hs.getFrontendSettings(c)
 	if err != nil {
@@ -59,6 +77,17 @@ func (hs *HTTPServer) getFrontendSettings(c *contextmodel.ReqContext) (*dtos.Fro
 		}
 	}
 
+	preferences := hs.getFrontendPreferences(c.Req.Context(), c.SignedInUser)
+	if preferredDS := hs.getPreferredDefaultDataSource(preferences, dataSources); preferredDS != "" {
+		defaultDS = preferredDS
+	}
+
+	// Only flag the effective default, so the frontend doesn't have to match names against DefaultDatasource
+	for n, ds := range dataSources {
+		ds.IsDefault = n == defaultDS
+		dataSources[n] = ds
+	}
+
 	panels := make(map[string]plugins.PanelDTO)
 	for _, ap := range availablePlugins[plugins.TypePanel] {
 		panel := ap.Plugin
@@ -97,10 +126,16 @@ func (hs *HTTPServer) getFrontendSettings(c *contextmodel.ReqContext) (*dtos.Fro
 		buildstamp = 0
 	}
 
+	availableLocales, defaultLocale := hs.getLocaleSettings(preferences)
+
 	hasAccess := accesscontrol.HasAccess(hs.AccessControl, c)
 	secretsManagerPluginEnabled := kvstore.EvaluateRemoteSecretsPlugin(c.Req.Context(), hs.secretsPluginManager, hs.Cfg) == nil
 	trustedTypesDefaultPolicyEnabled := (hs.Cfg.CSPEnabled && strings.Contains(hs.Cfg.CSPTemplate, "require-trusted-types-for")) || (hs.Cfg.CSPReportOnlyEnabled && strings.Contains(hs.Cfg.CSPReportOnlyTemplate, "require-trusted-types-for"))
 
+	renderingSettings := hs.getRenderingSettings(c.Req.Context())
+	namespace, orgName := hs.getOrgContext(c.SignedInUser)
+	degradedReasons := hs.getDegradedReasons(c.Req.Context())
+
 	frontendSettings := &dtos.FrontendSettingsDTO{
 		DefaultDatasource:                   defaultDS,
 		Datasources:                         dataSources,
@@ -153,10 +188,73 @@ func (hs *HTTPServer) getFrontendSettings(c *contextmodel.ReqContext) (*dtos.Fro
 		TrustedTypesDefaultPolicyEnabled:    trustedTypesDefaultPolicyEnabled,
 		CSPReportOnlyEnabled:                hs.Cfg.CSPReportOnlyEnabled,
 		DateFormats:                         hs.Cfg.DateFormats,
+		AvailableLocales:                    availableLocales,
+		DefaultLocale:                       defaultLocale,
 		SecureSocksDSProxyEnabled:           hs.Cfg.SecureSocksDSProxy.Enabled && hs.Cfg.SecureSocksDSProxy.ShowUI,
 		DisableFrontendSandboxForPlugins:    hs.Cfg.DisableFrontendSandboxForPlugins,
+		DefaultLogsSortOrder:                hs.Cfg.Panels.DefaultLogsSortOrder,
+		ApiVersionHash:                      hs.apiVersionHash,
+		AngularPlugins:                      hs.getAngularPlugins(c),
+		ProvisioningReloadIntervalSeconds:   int(hs.ProvisioningService.GetDashboardProvisionerUpdateIntervalSeconds()),
+		AutoLogoutOnIdle:                    hs.Cfg.AutoLogoutOnIdle,
+		IdleTimeoutSeconds:                  int(hs.Cfg.IdleTimeout.Seconds()),
+		SignoutRedirectUrl:                  hs.Cfg.SignoutRedirectUrl,
+		SmtpEnabled:                         hs.Cfg.Smtp.Enabled,
+		ResetPasswordEnabled:                !hs.Cfg.DisableResetPassword && !hs.Cfg.DisableLoginForm,
+		MaxSearchResults:                    hs.Cfg.Search.MaxResults,
+		MustChangePassword:                  c.SignedInUser.MustChangePassword,
+		TeamPickerPageSize:                  hs.Cfg.TeamPickerPageSize,
+		ServerTimeMillis:                    time.Now().UnixMilli(),
+		ServerTimezone:                      serverTimezone(),
+		Namespace:                           namespace,
+		OrgId:                               c.SignedInUser.GetOrgID(),
+		OrgName:                             orgName,
+		UnifiedStorageEnabled:               hs.unifiedStorageEnabled,
+		MaxConcurrentTransformations:        hs.Cfg.Panels.MaxConcurrentTransformations,
+		DefaultTextPanelContent:             hs.Cfg.Panels.DefaultTextPanelContent,
+		MaxTemplateVariables:                hs.Cfg.DashboardMaxTemplateVariables,
+		MaxDashboardPanels:                  hs.Cfg.DashboardMaxPanels,
+		MaxPanelLinks:                       hs.Cfg.Panels.MaxPanelLinks,
+		PauseRefreshWhileEditing:            hs.Cfg.DashboardPauseRefreshWhileEditing,
+		MaxDatasourceConnections:            hs.Cfg.DataSourceMaxFrontendConnections,
+		DefaultReduceCalc:                   hs.Cfg.Panels.DefaultReduceCalc,
+		MaxEmbedRequestsPerMinute:           hs.Cfg.MaxEmbedRequestsPerMinute,
+		MaxSessionQueryHistory:              hs.Cfg.Explore.MaxSessionQueryHistory,
+		MaxExternalSnapshots:                hs.Cfg.MaxExternalSnapshots,
+		DefaultPanelMinInterval:             hs.Cfg.Panels.DefaultMinInterval,
+		MaxConcurrentAnnotationQueries:      hs.Cfg.AnnotationMaxConcurrentQueries,
+		DefaultPanelBorderStyle:             hs.Cfg.Panels.DefaultBorderStyle,
+		MinVariableRefreshIntervalSeconds:   hs.Cfg.DashboardMinVariableRefreshIntervalSeconds,
+		DefaultAllValue:                     hs.Cfg.DashboardDefaultAllValue,
+		MaxDashboardRenderQueue:             hs.Cfg.DashboardMaxRenderQueue,
+		DefaultFillOpacity:                  hs.Cfg.Panels.DefaultFillOpacity,
+		MaxFolderTreeNodes:                  hs.Cfg.DashboardMaxFolderTreeNodes,
+		DefaultDashboardLinkIcon:            hs.Cfg.DashboardDefaultLinkIcon,
+		DefaultDashboardLinkTooltip:         hs.Cfg.DashboardDefaultLinkTooltip,
+		MaxInlineSVGBytes:                   hs.Cfg.Panels.MaxInlineSVGBytes,
+		DefaultExploreRangeSelectAction:     hs.Cfg.Explore.DefaultRangeSelectAction,
+		DefaultShowPanelDescription:         hs.Cfg.Panels.DefaultShowDescription,
+		MaxBreadcrumbDepth:                  hs.Cfg.Navigation.MaxBreadcrumbDepth,
+		DefaultDatasourceVariableFilter:     hs.Cfg.DashboardDefaultDatasourceVariableFilter,
+		MaxDashboardFields:                  hs.Cfg.DashboardMaxJSONFields,
+		DefaultExploreSupplementaryQueries:  !hs.Cfg.Explore.DisableSupplementaryQueries,
+		MaxVariablesPerQuery:                hs.Cfg.QueryMaxVariables,
+		DefaultTimeShift:                    hs.Cfg.Panels.DefaultTimeShift,
+		DefaultRepeatScope:                  hs.Cfg.Panels.DefaultRepeatScope,
+		MaxSearchQueryLength:                hs.Cfg.Search.MaxQueryLength,
+		MaxDatasourceInstancesPerPlugin:     hs.Cfg.DataSourceMaxInstancesPerPlugin,
+		MaxRenderedAnnotations:              hs.Cfg.AnnotationMaxRendered,
+		DefaultVariableMultiSelect:          hs.Cfg.DashboardDefaultVariableMultiSelect,
+		MaxRefreshesBeforePause:             hs.Cfg.DashboardMaxRefreshesBeforePause,
+		DefaultThresholdSteps:               hs.Cfg.Panels.DefaultThresholdSteps,
+		DefaultSpecialValueMappings:         hs.Cfg.Panels.DefaultSpecialValueMappings,
+		LogLevelColorMap:                    hs.Cfg.Explore.LogLevelColors,
+		DefaultExploreVizByDatasourceType:   hs.Cfg.Explore.DefaultVizByDatasourceType,
+		ExploreRowLimitByType:               hs.Cfg.Explore.RowLimitByDatasourceType,
 		PublicDashboardAccessToken:          c.PublicDashboardAccessToken,
 
+		ApplicationInsightsSamplingPercentage: hs.Cfg.ApplicationInsightsSamplingPercentage,
+
 		Auth: dtos.FrontendSettingsAuthDTO{
 			OAuthSkipOrgRoleUpdateSync:  hs.Cfg.OAuthSkipOrgRoleUpdateSync,
 			SAMLSkipOrgRoleSync:         hs.Cfg.SAMLSkipOrgRoleSync,
@@ -181,6 +279,9 @@ func (hs *HTTPServer) getFrontendSettings(c *contextmodel.ReqContext) (*dtos.Fro
 			LatestVersion: hs.grafanaUpdateChecker.LatestVersion(),
 			HasUpdate:     hs.grafanaUpdateChecker.UpdateAvailable(),
 			Env:           setting.Env,
+
+			DegradedMode:    len(degradedReasons) > 0,
+			DegradedReasons: degradedReasons,
 		},
 
 		LicenseInfo: dtos.FrontendSettingsLicenseInfoDTO{
@@ -193,11 +294,11 @@ func (hs *HTTPServer) getFrontendSettings(c *contextmodel.ReqContext) (*dtos.Fro
 
 		FeatureToggles:                   hs.Features.GetEnabled(c.Req.Context()),
 		AnonymousEnabled:                 hs.Cfg.AnonymousEnabled,
-		RendererAvailable:                hs.RenderService.IsAvailable(c.Req.Context()),
-		RendererVersion:                  hs.RenderService.Version(),
+		RendererAvailable:                renderingSettings.Available,
+		RendererVersion:                  renderingSettings.Version,
 		SecretsManagerPluginEnabled:      secretsManagerPluginEnabled,
 		Http2Enabled:                     hs.Cfg.Protocol == setting.HTTP2Scheme,
-		GrafanaJavascriptAgent:           hs.Cfg.GrafanaJavascriptAgent,
+		GrafanaJavascriptAgent:           hs.getGrafanaJavascriptAgentSettings(c),
 		PluginCatalogURL:                 hs.Cfg.PluginCatalogURL,
 		PluginAdminEnabled:               hs.Cfg.PluginAdminEnabled,
 		PluginAdminExternalManageEnabled: hs.Cfg.PluginAdminEnabled && hs.Cfg.PluginAdminExternalManageEnabled,
@@ -228,7 +329,9 @@ func (hs *HTTPServer) getFrontendSettings(c *contextmodel.ReqContext) (*dtos.Fro
 		},
 
 		UnifiedAlerting: dtos.FrontendSettingsUnifiedAlertingDTO{
-			MinInterval: hs.Cfg.UnifiedAlerting.MinInterval.String(),
+			MinInterval:              hs.Cfg.UnifiedAlerting.MinInterval.String(),
+			DefaultAlertContactPoint: hs.Cfg.UnifiedAlerting.DefaultContactPoint,
+			MaxRulesPerGroup:         hs.Cfg.UnifiedAlerting.MaxRulesPerGroup,
 		},
 
 		Oauth:                   hs.getEnabledOAuthProviders(),
@@ -243,6 +346,31 @@ func (hs *HTTPServer) getFrontendSettings(c *contextmodel.ReqContext) (*dtos.Fro
 			MaxIdleConns:    hs.Cfg.SqlDatasourceMaxIdleConnsDefault,
 			ConnMaxLifetime: hs.Cfg.SqlDatasourceMaxConnLifetimeDefault,
 		},
+
+		SecretsManager: hs.getSecretsManagerSettings(c.Req.Context(), c.IsGrafanaAdmin, secretsManagerPluginEnabled),
+
+		Navigation: hs.getNavigationSettings(c.Req.Context(), c.SignedInUser),
+
+		Avatars: dtos.FrontendSettingsAvatarsDTO{
+			GravatarEnabled:  !setting.DisableGravatar,
+			DefaultAvatarUrl: hs.Cfg.AppSubURL + "/public/img/user_profile.png",
+			CustomSourceUrl:  setting.AvatarSourceURL,
+		},
+
+		Rendering: renderingSettings,
+
+		Extensions: hs.HooksService.RunFrontendSettingsExtensions(c),
+	}
+
+	if !hs.analyticsKeysAllowed(c, frontendSettings.Analytics.Enabled) {
+		frontendSettings.GoogleAnalyticsId = ""
+		frontendSettings.GoogleAnalytics4Id = ""
+		frontendSettings.RudderstackWriteKey = ""
+		frontendSettings.ApplicationInsightsConnectionString = ""
+	}
+
+	if !enabledForRole(c, hs.Cfg.ApplicationInsightsEnabledForRoles) {
+		frontendSettings.ApplicationInsightsConnectionString = ""
 	}
 
 	if hs.Cfg.UnifiedAlerting.StateHistory.Enabled {
@@ -277,6 +405,304 @@ func (hs *HTTPServer) getFrontendSettings(c *contextmodel.ReqContext) (*dtos.Fro
 	return frontendSettings, nil
 }
 
+// validateFooterLinks drops custom footer links with a URL that isn't an absolute http(s) or
+// mailto URL, replaces unknown icons with the default one and caps the number of links.
+func (hs *HTTPServer) validateFooterLinks(links []dtos.FrontendSettingsFooterConfigItemDTO) []dtos.FrontendSettingsFooterConfigItemDTO {
+	valid := make([]dtos.FrontendSettingsFooterConfigItemDTO, 0, len(links))
+	for _, link := range links {
+		if len(valid) == setting.MaxFooterLinks {
+			hs.log.Warn("Too many footer links configured, ignoring the remaining links", "max", setting.MaxFooterLinks, "configured", len(links))
+			break
+		}
+
+		if err := setting.ValidateFooterLinkURL(link.Url); err != nil {
+			hs.log.Warn("Ignoring footer link with an invalid url", "text", link.Text, "error", err)
+			continue
+		}
+
+		if !setting.IsValidFooterLinkIcon(link.Icon) {
+			if link.Icon != "" {
+				hs.log.Warn("Footer link has an unknown icon, falling back to the default icon", "text", link.Text, "icon", link.Icon)
+			}
+			link.Icon = setting.DefaultFooterLinkIcon
+		}
+
+		valid = append(valid, link)
+	}
+	return valid
+}
+
+// analyticsKeysAllowed reports whether the frontend analytics keys can be sent to the user.
+// They're always sent unless restricted in the [analytics] section.
+func (hs *HTTPServer) analyticsKeysAllowed(c *contextmodel.ReqContext, analyticsEnabled bool) bool {
+	if hs.Cfg.AnalyticsKeysRequireEnabled && !analyticsEnabled {
+		return false
+	}
+	if hs.Cfg.AnalyticsKeysHideFromAnonymous && !c.IsSignedIn {
+		return false
+	}
+	return true
+}
+
+// enabledForRole reports whether a frontend integration restricted to the given org roles
+// is enabled for the user. An empty list enables it for all roles.
+func enabledForRole(c *contextmodel.ReqContext, roles []string) bool {
+	if len(roles) == 0 {
+		return true
+	}
+
+	role := string(c.SignedInUser.GetOrgRole())
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// getGrafanaJavascriptAgentSettings returns the agent settings, with the agent disabled for
+// users whose role it isn't enabled for.
+func (hs *HTTPServer) getGrafanaJavascriptAgentSettings(c *contextmodel.ReqContext) setting.GrafanaJavascriptAgent {
+	agent := hs.Cfg.GrafanaJavascriptAgent
+	if !enabledForRole(c, agent.EnabledForRoles) {
+		return setting.GrafanaJavascriptAgent{}
+	}
+	return agent
+}
+
+// getOrgContext returns the namespace and name of the user's current org. The namespace is built
+// by the same mapper the API server uses, so clients can use it to talk to the app platform APIs.
+// Both are empty for requests without an org, such as the login page.
+func (hs *HTTPServer) getOrgContext(user identity.Requester) (string, string) {
+	orgID := user.GetOrgID()
+	if orgID < 1 {
+		return "", ""
+	}
+
+	return request.GetNamespaceMapper(hs.Cfg)(orgID), user.GetOrgName()
+}
+
+// getRenderingSettings describes the image renderer so that the frontend can disable
+// the render options that aren't supported.
+func (hs *HTTPServer) getRenderingSettings(ctx context.Context) dtos.FrontendSettingsRenderingDTO {
+	formats := rendering.SupportedFormats(ctx, hs.RenderService)
+	settings := dtos.FrontendSettingsRenderingDTO{
+		Available:             len(formats) > 0,
+		Version:               hs.RenderService.Version(),
+		SupportedFormats:      make([]string, 0, len(formats)),
+		ConcurrencyLimit:      hs.Cfg.RendererConcurrentRequestLimit,
+		DefaultTimeoutSeconds: defaultRenderTimeoutSeconds,
+	}
+	for _, format := range formats {
+		settings.SupportedFormats = append(settings.SupportedFormats, string(format))
+	}
+	return settings
+}
+
+// getNavigationSettings returns the navigation customization for the user's org. Lists set
+// in the org preferences take precedence over the instance settings. Grafana server admins
+// always see the Administration section.
+func (hs *HTTPServer) getNavigationSettings(ctx context.Context, user identity.Requester) dtos.FrontendSettingsNavigationDTO {
+	navigation := pref.NavigationPreference{
+		HiddenSections: hs.Cfg.Navigation.HiddenSections,
+		PinnedItems:    hs.Cfg.Navigation.PinnedItems,
+	}
+
+	if orgID := user.GetOrgID(); orgID != 0 {
+		preference, err := hs.preferenceService.Get(ctx, &pref.GetPreferenceQuery{OrgID: orgID})
+		if err != nil {
+			hs.log.Warn("Failed to get org preferences for navigation", "orgId", orgID, "error", err)
+		} else if preference.JSONData != nil && preference.JSONData.Navigation != nil {
+			if preference.JSONData.Navigation.HiddenSections != nil {
+				navigation.HiddenSections = preference.JSONData.Navigation.HiddenSections
+			}
+			if preference.JSONData.Navigation.PinnedItems != nil {
+				navigation.PinnedItems = preference.JSONData.Navigation.PinnedItems
+			}
+		}
+	}
+
+	hiddenSections := make([]string, 0, len(navigation.HiddenSections))
+	for _, id := range navigation.HiddenSections {
+		if id == navtree.NavIDCfg && user.GetIsGrafanaAdmin() {
+			continue
+		}
+		hiddenSections = append(hiddenSections, id)
+	}
+
+	pinnedItems := make([]string, 0, len(navigation.PinnedItems))
+	pinnedItems = append(pinnedItems, navigation.PinnedItems...)
+
+	return dtos.FrontendSettingsNavigationDTO{
+		HiddenSections: hiddenSections,
+		PinnedItems:    pinnedItems,
+	}
+}
+
+// serverTimezone returns the IANA name of the timezone the server runs in.
+var serverTimezone = sync.OnceValue(func() string {
+	return localTimezoneName(os.Getenv("TZ"), "/etc/localtime")
+})
+
+// localTimezoneName returns the IANA name of the local timezone, taken from the TZ
+// environment variable or from the target of the localtime symlink. UTC is returned
+// when neither holds a known timezone.
+func localTimezoneName(tz string, localtimePath string) string {
+	if tz = strings.TrimPrefix(tz, ":"); tz != "" {
+		if _, err := time.LoadLocation(tz); err == nil {
+			return tz
+		}
+	}
+
+	if target, err := os.Readlink(localtimePath); err == nil {
+		if _, name, found := strings.Cut(target, "zoneinfo/"); found {
+			if _, err := time.LoadLocation(name); err == nil {
+				return name
+			}
+		}
+	}
+
+	return "UTC"
+}
+
+// getAngularPlugins returns the sorted IDs of the installed plugins that depend on Angular.
+// The list is only returned to admins so that the plugin inventory isn't exposed to every user.
+func (hs *HTTPServer) getAngularPlugins(c *contextmodel.ReqContext) []string {
+	angularPlugins := []string{}
+	if !c.IsGrafanaAdmin && !c.HasRole(org.RoleAdmin) {
+		return angularPlugins
+	}
+
+	for _, p := range hs.pluginStore.Plugins(c.Req.Context()) {
+		if p.Angular.Detected {
+			angularPlugins = append(angularPlugins, p.ID)
+		}
+	}
+	slices.Sort(angularPlugins)
+
+	return angularPlugins
+}
+
+// getSecretsManagerSettings returns the state of the secrets manager plugin. Only server admins
+// get the plugin and migration details, everyone else only learns whether the plugin is enabled.
+func (hs *HTTPServer) getSecretsManagerSettings(ctx context.Context, isGrafanaAdmin bool, enabled bool) dtos.FrontendSettingsSecretsManagerDTO {
+	settings := dtos.FrontendSettingsSecretsManagerDTO{Enabled: enabled}
+	if !isGrafanaAdmin {
+		return settings
+	}
+
+	if enabled {
+		if p := hs.secretsPluginManager.SecretsManager(ctx); p != nil {
+			settings.PluginID = p.ID
+		}
+	}
+
+	status, err := kvstore.GetPluginMigrationStatus(ctx, kvstore.GetNamespacedKVStore(hs.kvStore))
+	if err != nil {
+		hs.log.Warn("Failed to get secrets plugin migration status", "error", err)
+	} else {
+		settings.MigrationStatus = string(status)
+	}
+
+	backwardsCompatibilityEnabled := !hs.Features.IsEnabled(featuremgmt.FlagDisableSecretsCompatibility)
+	settings.BackwardsCompatibilityEnabled = &backwardsCompatibilityEnabled
+
+	return settings
+}
+
+// getFrontendPreferences returns the user, team and org preferences merged in a single
+// query. Failing to load them doesn't block the frontend from booting, the error is logged
+// and nil is returned so that the instance defaults are used.
+func (hs *HTTPServer) getFrontendPreferences(ctx context.Context, user identity.Requester) *pref.Preference {
+	orgID := user.GetOrgID()
+	if orgID == 0 {
+		return nil
+	}
+
+	userID, _ := identity.UserIdentifier(user.GetNamespacedID())
+	preference, err := hs.preferenceService.GetWithDefaults(ctx, &pref.GetPreferenceWithDefaultsQuery{UserID: userID, OrgID: orgID, Teams: user.GetTeams()})
+	if err != nil {
+		hs.log.Warn("Failed to get preferences for frontend settings", "orgId", orgID, "error", err)
+		return nil
+	}
+
+	return preference
+}
+
+// getPreferredDefaultDataSource returns the name of the default data source set in the
+// user or team preferences, with the user preference taking precedence. A preference
+// pointing at a data source that has been removed or that the user can't query is
+// ignored, and an empty string is returned so that the organization default is used.
+func (hs *HTTPServer) getPreferredDefaultDataSource(preference *pref.Preference, dataSources map[string]plugins.DataSourceDTO) string {
+	if preference == nil || preference.JSONData == nil || preference.JSONData.DefaultDatasourceUID == "" {
+		return ""
+	}
+
+	uid := preference.JSONData.DefaultDatasourceUID
+	for name, ds := range dataSources {
+		if ds.UID == uid {
+			return name
+		}
+	}
+	hs.log.Debug("Ignoring default data source preference, data source not found or not accessible", "uid", uid)
+
+	return ""
+}
+
+// getLocaleSettings returns the locales for which translation bundles are installed and
+// the locale the frontend should start with. The default locale is taken from the
+// user, team and org preferences and falls back to English, or to the first installed
+// locale when there is no English bundle.
+func (hs *HTTPServer) getLocaleSettings(prefs *pref.Preference) ([]string, string) {
+	available := hs.locales
+	if available == nil {
+		available = []string{}
+	}
+
+	defaultLocale := fallbackLocale
+	if len(available) > 0 && !slices.Contains(available, fallbackLocale) {
+		defaultLocale = available[0]
+	}
+	if prefs != nil && prefs.JSONData != nil && slices.Contains(available, prefs.JSONData.Language) {
+		defaultLocale = prefs.JSONData.Language
+	}
+
+	return available, defaultLocale
+}
+
+// findLocales lists the locales that have a translation bundle in the static root. The
+// pseudo locale is only listed in development. It's called once at startup, entries
+// that can't be read are logged and skipped.
+func findLocales(cfg *setting.Cfg, logger log.Logger) []string {
+	localesPath := filepath.Join(cfg.StaticRootPath, "locales")
+	entries, err := os.ReadDir(localesPath)
+	if err != nil {
+		if !errors.Is(err, fs.ErrNotExist) {
+			logger.Warn("Failed to read translation bundles", "path", localesPath, "error", err)
+		}
+		return []string{}
+	}
+
+	locales := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		if entry.Name() == pseudoLocale && cfg.Env != setting.Dev {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(localesPath, entry.Name(), "grafana.json")); err != nil {
+			if !errors.Is(err, fs.ErrNotExist) {
+				logger.Warn("Skipping unreadable translation bundle", "locale", entry.Name(), "error", err)
+			}
+			continue
+		}
+		locales = append(locales, entry.Name())
+	}
+
+	return locales
+}
+
 func isSupportBundlesEnabled(hs *HTTPServer) bool {
 	return hs.Cfg.SectionWithEnvOverrides("support_bundles").Key("enabled").MustBool(true)
 }
@@ -414,6 +840,8 @@ func (hs *HTTPServer) getFSDataSources(c *contextmodel.ReqContext, availablePlug
 				Type:     string(ds.Type),
 				Name:     ds.Name,
 				JSONData: make(map[string]any),
+				// built-in data sources can't be edited
+				ReadOnly: true,
 				PluginMeta: &plugins.PluginMetaDTO{
 					JSONData:  ds.JSONData,
 					Signature: ds.Signature,
@@ -1,17 +1,27 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"path/filepath"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/grafana/grafana/pkg/api/dtos"
 	"github.com/grafana/grafana/pkg/infra/db"
+	"github.com/grafana/grafana/pkg/infra/kvstore"
+	"github.com/grafana/grafana/pkg/infra/localcache"
+	"github.com/grafana/grafana/pkg/infra/log"
 	"github.com/grafana/grafana/pkg/infra/remotecache"
 	"github.com/grafana/grafana/pkg/infra/usagestats"
 	"github.com/grafana/grafana/pkg/login/social"
@@ -19,13 +29,27 @@ import (
 	"github.com/grafana/grafana/pkg/plugins/config"
 	"github.com/grafana/grafana/pkg/plugins/pluginscdn"
 	accesscontrolmock "github.com/grafana/grafana/pkg/services/accesscontrol/mock"
+	contextmodel "github.com/grafana/grafana/pkg/services/contexthandler/model"
+	"github.com/grafana/grafana/pkg/services/datasources"
+	fakeDatasources "github.com/grafana/grafana/pkg/services/datasources/fakes"
+	"github.com/grafana/grafana/pkg/services/datasources/guardian"
 	"github.com/grafana/grafana/pkg/services/featuremgmt"
+	"github.com/grafana/grafana/pkg/services/hooks"
 	"github.com/grafana/grafana/pkg/services/licensing"
+	"github.com/grafana/grafana/pkg/services/org"
+	"github.com/grafana/grafana/pkg/services/org/orgtest"
 	"github.com/grafana/grafana/pkg/services/pluginsintegration/pluginsettings"
 	"github.com/grafana/grafana/pkg/services/pluginsintegration/pluginstore"
+	pref "github.com/grafana/grafana/pkg/services/preference"
+	"github.com/grafana/grafana/pkg/services/preference/prefimpl"
+	"github.com/grafana/grafana/pkg/services/preference/preftest"
+	"github.com/grafana/grafana/pkg/services/provisioning"
 	"github.com/grafana/grafana/pkg/services/rendering"
+	secretskvs "github.com/grafana/grafana/pkg/services/secrets/kvstore"
 	"github.com/grafana/grafana/pkg/services/supportbundles/supportbundlestest"
 	"github.com/grafana/grafana/pkg/services/updatechecker"
+	"github.com/grafana/grafana/pkg/services/user"
+	"github.com/grafana/grafana/pkg/services/user/usertest"
 	"github.com/grafana/grafana/pkg/setting"
 	"github.com/grafana/grafana/pkg/web"
 )
@@ -56,6 +80,8 @@ func setupTestEnvironment(t *testing.T, cfg *setting.Cfg, features *featuremgmt.
 		pluginsSettings = &pluginsettings.FakePluginSettings{}
 	}
 
+	sqlStore := db.InitTestDB(t)
+
 	hs := &HTTPServer{
 		Cfg:      cfg,
 		Features: features,
@@ -64,7 +90,14 @@ func setupTestEnvironment(t *testing.T, cfg *setting.Cfg, features *featuremgmt.
 			Cfg:                   cfg,
 			RendererPluginManager: &fakeRendererManager{},
 		},
-		SQLStore:             db.InitTestDB(t),
+		SQLStore:             sqlStore,
+		CacheService:         localcache.ProvideService(),
+		ProvisioningService:  provisioning.NewProvisioningServiceMock(context.Background()),
+		preferenceService:    prefimpl.ProvideService(sqlStore, cfg, features),
+		kvStore:              kvstore.ProvideService(sqlStore),
+		userService:          &usertest.FakeUserService{ExpectedUser: &user.User{}},
+		orgService:           &orgtest.FakeOrgService{ExpectedOrg: &org.Org{}},
+		HooksService:         hooks.ProvideService(),
 		SettingsProvider:     setting.ProvideProvider(cfg),
 		pluginStore:          pluginStore,
 		grafanaUpdateChecker: &updatechecker.GrafanaService{},
@@ -205,6 +238,340 @@ func TestHTTPServer_GetFrontendSettings_pluginsCDNBaseURL(t *testing.T) {
 	}
 }
 
+func TestHTTPServer_GetFrontendSettings_provisioningReloadInterval(t *testing.T) {
+	type settings struct {
+		ProvisioningReloadIntervalSeconds int `json:"provisioningReloadIntervalSeconds"`
+	}
+
+	cfg := setting.NewCfg()
+	m, hs := setupTestEnvironment(t, cfg, featuremgmt.WithFeatures(), nil, nil)
+	hs.ProvisioningService = &provisioning.ProvisioningServiceMock{
+		Calls: &provisioning.Calls{},
+		GetDashboardProvisionerUpdateIntervalSecondsFunc: func() int64 {
+			return 30
+		},
+	}
+	req := httptest.NewRequest(http.MethodGet, "/api/frontend/settings", nil)
+
+	recorder := httptest.NewRecorder()
+	m.ServeHTTP(recorder, req)
+	var got settings
+	err := json.Unmarshal(recorder.Body.Bytes(), &got)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, 30, got.ProvisioningReloadIntervalSeconds)
+}
+
+func TestHTTPServer_GetFrontendSettings_fromCfg(t *testing.T) {
+	tests := []struct {
+		desc      string
+		mutateCfg func(*setting.Cfg)
+		// expected maps dotted JSON paths in the response to their expected values
+		expected map[string]any
+	}{
+		{
+			desc: "idle logout enabled with a redirect",
+			mutateCfg: func(cfg *setting.Cfg) {
+				cfg.AutoLogoutOnIdle = true
+				cfg.IdleTimeout = 30 * time.Minute
+				cfg.SignoutRedirectUrl = "https://sso.example.com/portal"
+			},
+			expected: map[string]any{"autoLogoutOnIdle": true, "idleTimeoutSeconds": 1800, "signoutRedirectUrl": "https://sso.example.com/portal"},
+		},
+		{
+			desc:     "idle logout is disabled by default",
+			expected: map[string]any{"autoLogoutOnIdle": false},
+		},
+		{
+			desc:      "SMTP and password reset enabled",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Smtp.Enabled = true },
+			expected:  map[string]any{"smtpEnabled": true, "resetPasswordEnabled": true},
+		},
+		{
+			desc: "password reset disabled with SMTP configured",
+			mutateCfg: func(cfg *setting.Cfg) {
+				cfg.Smtp.Enabled = true
+				cfg.DisableResetPassword = true
+			},
+			expected: map[string]any{"smtpEnabled": true, "resetPasswordEnabled": false},
+		},
+		{
+			desc:     "SMTP disabled",
+			expected: map[string]any{"smtpEnabled": false, "resetPasswordEnabled": true},
+		},
+		{
+			desc:     "no log level colors configured leaves logLevelColorMap out",
+			expected: map[string]any{"logLevelColorMap": nil},
+		},
+		{
+			desc: "custom log levels are colored per config",
+			mutateCfg: func(cfg *setting.Cfg) {
+				cfg.Explore.LogLevelColors = map[string]string{"notice": "blue", "audit": "#8f3bb8"}
+			},
+			expected: map[string]any{"logLevelColorMap": map[string]string{"notice": "blue", "audit": "#8f3bb8"}},
+		},
+		{
+			desc:      "team picker page size",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.TeamPickerPageSize = 25 },
+			expected:  map[string]any{"teamPickerPageSize": 25},
+		},
+		{
+			desc: "interval formats",
+			mutateCfg: func(cfg *setting.Cfg) {
+				cfg.DateFormats.Interval.Day = "MM/DD"
+				cfg.DateFormats.IntervalFormats = map[string]string{"day": "ddd MM/DD"}
+			},
+			expected: map[string]any{
+				"dateFormats.interval.day":    "MM/DD",
+				"dateFormats.intervalFormats": map[string]string{"day": "ddd MM/DD"},
+			},
+		},
+		{
+			desc:      "max concurrent transformations",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Panels.MaxConcurrentTransformations = 3 },
+			expected:  map[string]any{"maxConcurrentTransformations": 3},
+		},
+		{
+			desc:      "default text panel content",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Panels.DefaultTextPanelContent = "# Service overview\n\nOwner: " },
+			expected:  map[string]any{"defaultTextPanelContent": "# Service overview\n\nOwner: "},
+		},
+		{
+			desc: "dashboard limits",
+			mutateCfg: func(cfg *setting.Cfg) {
+				cfg.DashboardMaxTemplateVariables = 50
+				cfg.DashboardMaxPanels = 200
+			},
+			expected: map[string]any{"maxTemplateVariables": 50, "maxDashboardPanels": 200},
+		},
+		{
+			desc:      "max panel links",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Panels.MaxPanelLinks = 5 },
+			expected:  map[string]any{"maxPanelLinks": 5},
+		},
+		{
+			desc:      "pause refresh while editing",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.DashboardPauseRefreshWhileEditing = true },
+			expected:  map[string]any{"pauseRefreshWhileEditing": true},
+		},
+		{
+			desc:      "max data source connections",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.DataSourceMaxFrontendConnections = 10 },
+			expected:  map[string]any{"maxDatasourceConnections": 10},
+		},
+		{
+			desc:      "default reduce calculation",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Panels.DefaultReduceCalc = "mean" },
+			expected:  map[string]any{"defaultReduceCalc": "mean"},
+		},
+		{
+			desc:      "max embed requests per minute",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.MaxEmbedRequestsPerMinute = 30 },
+			expected:  map[string]any{"maxEmbedRequestsPerMinute": 30},
+		},
+		{
+			desc:      "default alert contact point",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.UnifiedAlerting.DefaultContactPoint = "team-oncall" },
+			expected:  map[string]any{"unifiedAlerting.defaultAlertContactPoint": "team-oncall"},
+		},
+		{
+			desc:      "max session query history",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Explore.MaxSessionQueryHistory = 20 },
+			expected:  map[string]any{"maxSessionQueryHistory": 20},
+		},
+		{
+			desc:      "default Explore visualization by data source type",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Explore.DefaultVizByDatasourceType = map[string]string{"loki": "logs"} },
+			expected:  map[string]any{"defaultExploreVizByDatasourceType": map[string]string{"loki": "logs"}},
+		},
+		{
+			desc:      "max external snapshots",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.MaxExternalSnapshots = 10 },
+			expected:  map[string]any{"maxExternalSnapshots": 10},
+		},
+		{
+			desc:      "default panel min interval",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Panels.DefaultMinInterval = "5s" },
+			expected:  map[string]any{"defaultPanelMinInterval": "5s"},
+		},
+		{
+			desc:      "max concurrent annotation queries",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.AnnotationMaxConcurrentQueries = 2 },
+			expected:  map[string]any{"maxConcurrentAnnotationQueries": 2},
+		},
+		{
+			desc:      "default panel border style",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Panels.DefaultBorderStyle = "shadow" },
+			expected:  map[string]any{"defaultPanelBorderStyle": "shadow"},
+		},
+		{
+			desc:      "min variable refresh interval",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.DashboardMinVariableRefreshIntervalSeconds = 30 },
+			expected:  map[string]any{"minVariableRefreshIntervalSeconds": 30},
+		},
+		{
+			desc:      "default all value",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.DashboardDefaultAllValue = ".*" },
+			expected:  map[string]any{"defaultAllValue": ".*"},
+		},
+		{
+			desc:      "max dashboard render queue",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.DashboardMaxRenderQueue = 20 },
+			expected:  map[string]any{"maxDashboardRenderQueue": 20},
+		},
+		{
+			desc:      "default fill opacity",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Panels.DefaultFillOpacity = 25 },
+			expected:  map[string]any{"defaultFillOpacity": 25},
+		},
+		{
+			desc:      "max folder tree nodes",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.DashboardMaxFolderTreeNodes = 500 },
+			expected:  map[string]any{"maxFolderTreeNodes": 500},
+		},
+		{
+			desc: "default dashboard link",
+			mutateCfg: func(cfg *setting.Cfg) {
+				cfg.DashboardDefaultLinkIcon = "info"
+				cfg.DashboardDefaultLinkTooltip = "Opens in the runbook"
+			},
+			expected: map[string]any{"defaultDashboardLinkIcon": "info", "defaultDashboardLinkTooltip": "Opens in the runbook"},
+		},
+		{
+			desc:      "max inline SVG bytes",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Panels.MaxInlineSVGBytes = 1048576 },
+			expected:  map[string]any{"maxInlineSVGBytes": 1048576},
+		},
+		{
+			desc:      "default Explore range select action",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Explore.DefaultRangeSelectAction = "copy" },
+			expected:  map[string]any{"defaultExploreRangeSelectAction": "copy"},
+		},
+		{
+			desc:      "max rules per group",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.UnifiedAlerting.MaxRulesPerGroup = 20 },
+			expected:  map[string]any{"unifiedAlerting.maxRulesPerGroup": 20},
+		},
+		{
+			desc:      "default show panel description",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Panels.DefaultShowDescription = true },
+			expected:  map[string]any{"defaultShowPanelDescription": true},
+		},
+		{
+			desc:      "max breadcrumb depth",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Navigation.MaxBreadcrumbDepth = 4 },
+			expected:  map[string]any{"maxBreadcrumbDepth": 4},
+		},
+		{
+			desc:      "default data source variable filter",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.DashboardDefaultDatasourceVariableFilter = "prometheus:/^prod/" },
+			expected:  map[string]any{"defaultDatasourceVariableFilter": "prometheus:/^prod/"},
+		},
+		{
+			desc:      "max dashboard fields",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.DashboardMaxJSONFields = 5000 },
+			expected:  map[string]any{"maxDashboardFields": 5000},
+		},
+		{
+			desc:     "Explore supplementary queries are enabled by default",
+			expected: map[string]any{"defaultExploreSupplementaryQueries": true},
+		},
+		{
+			desc:      "Explore supplementary queries disabled from config",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Explore.DisableSupplementaryQueries = true },
+			expected:  map[string]any{"defaultExploreSupplementaryQueries": false},
+		},
+		{
+			desc:      "max variables per query",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.QueryMaxVariables = 10 },
+			expected:  map[string]any{"maxVariablesPerQuery": 10},
+		},
+		{
+			desc:      "default time shift",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Panels.DefaultTimeShift = "1w" },
+			expected:  map[string]any{"defaultTimeShift": "1w"},
+		},
+		{
+			desc:      "default repeat scope",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Panels.DefaultRepeatScope = "row" },
+			expected:  map[string]any{"defaultRepeatScope": "row"},
+		},
+		{
+			desc:      "max search query length",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Search.MaxQueryLength = 200 },
+			expected:  map[string]any{"maxSearchQueryLength": 200},
+		},
+		{
+			desc: "default special value mappings",
+			mutateCfg: func(cfg *setting.Cfg) {
+				cfg.Panels.DefaultSpecialValueMappings = []map[string]any{
+					{"type": "special", "options": map[string]any{"match": "nan", "result": map[string]any{"text": "NaN"}}},
+				}
+			},
+			expected: map[string]any{"defaultSpecialValueMappings": []map[string]any{
+				{"type": "special", "options": map[string]any{"match": "nan", "result": map[string]any{"text": "NaN"}}},
+			}},
+		},
+		{
+			desc:      "max data source instances per plugin",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.DataSourceMaxInstancesPerPlugin = 50 },
+			expected:  map[string]any{"maxDatasourceInstancesPerPlugin": 50},
+		},
+		{
+			desc:      "Explore row limit by data source type",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.Explore.RowLimitByDatasourceType = map[string]int{"loki": 500} },
+			expected:  map[string]any{"exploreRowLimitByType": map[string]int{"loki": 500}},
+		},
+		{
+			desc:      "max rendered annotations",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.AnnotationMaxRendered = 100 },
+			expected:  map[string]any{"maxRenderedAnnotations": 100},
+		},
+		{
+			desc:      "default variable multi-select",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.DashboardDefaultVariableMultiSelect = true },
+			expected:  map[string]any{"defaultVariableMultiSelect": true},
+		},
+		{
+			desc:      "max refreshes before pause",
+			mutateCfg: func(cfg *setting.Cfg) { cfg.DashboardMaxRefreshesBeforePause = 30 },
+			expected:  map[string]any{"maxRefreshesBeforePause": 30},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := setting.NewCfg()
+			if test.mutateCfg != nil {
+				test.mutateCfg(cfg)
+			}
+			m, _ := setupTestEnvironment(t, cfg, featuremgmt.WithFeatures(), nil, nil)
+			req := httptest.NewRequest(http.MethodGet, "/api/frontend/settings", nil)
+
+			recorder := httptest.NewRecorder()
+			m.ServeHTTP(recorder, req)
+			require.Equal(t, http.StatusOK, recorder.Code)
+			var got map[string]any
+			require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+
+			for path, expected := range test.expected {
+				var value any = got
+				for _, key := range strings.Split(path, ".") {
+					object, ok := value.(map[string]any)
+					require.True(t, ok, "%s is not an object", path)
+					value = object[key]
+				}
+
+				expectedJSON, err := json.Marshal(expected)
+				require.NoError(t, err)
+				gotJSON, err := json.Marshal(value)
+				require.NoError(t, err)
+				assert.JSONEq(t, string(expectedJSON), string(gotJSON), path)
+			}
+		})
+	}
+}
+
 func TestHTTPServer_GetFrontendSettings_apps(t *testing.T) {
 	type settings struct {
 		Apps map[string]*plugins.AppDTO `json:"apps"`
@@ -346,3 +713,759 @@ func newAppSettings(id string, enabled bool) map[string]*pluginsettings.DTO {
 		},
 	}
 }
+
+func TestHTTPServer_getPreferredDefaultDataSource(t *testing.T) {
+	cfg := setting.NewCfg()
+	sqlStore := db.InitTestDB(t)
+	hs := &HTTPServer{
+		Cfg:               cfg,
+		preferenceService: prefimpl.ProvideService(sqlStore, cfg, featuremgmt.WithFeatures()),
+		log:               log.NewNopLogger(),
+	}
+
+	signedInUser := &user.SignedInUser{UserID: 1, OrgID: 1, Teams: []int64{1, 2}}
+	dataSources := map[string]plugins.DataSourceDTO{
+		"Org Prometheus":  {UID: "org-prometheus", IsDefault: true},
+		"Team Prometheus": {UID: "team-prometheus"},
+		"User Loki":       {UID: "user-loki"},
+	}
+
+	save := func(t *testing.T, userID, teamID int64, uid string) {
+		t.Helper()
+		err := hs.preferenceService.Save(context.Background(), &pref.SavePreferenceCommand{
+			OrgID:                1,
+			UserID:               userID,
+			TeamID:               teamID,
+			DefaultDatasourceUID: uid,
+		})
+		require.NoError(t, err)
+	}
+
+	t.Run("no preference falls back to the org default", func(t *testing.T) {
+		assert.Empty(t, hs.getPreferredDefaultDataSource(hs.getFrontendPreferences(context.Background(), signedInUser), dataSources))
+	})
+
+	t.Run("team preference overrides the org default", func(t *testing.T) {
+		save(t, 0, 2, "team-prometheus")
+		assert.Equal(t, "Team Prometheus", hs.getPreferredDefaultDataSource(hs.getFrontendPreferences(context.Background(), signedInUser), dataSources))
+	})
+
+	t.Run("user preference overrides the team preference", func(t *testing.T) {
+		save(t, 1, 0, "user-loki")
+		assert.Equal(t, "User Loki", hs.getPreferredDefaultDataSource(hs.getFrontendPreferences(context.Background(), signedInUser), dataSources))
+	})
+
+	t.Run("user preference of another org is ignored", func(t *testing.T) {
+		otherOrgUser := &user.SignedInUser{UserID: 1, OrgID: 2}
+		assert.Empty(t, hs.getPreferredDefaultDataSource(hs.getFrontendPreferences(context.Background(), otherOrgUser), dataSources))
+	})
+
+	t.Run("stale preference falls back to the org default", func(t *testing.T) {
+		withoutUserDS := map[string]plugins.DataSourceDTO{
+			"Org Prometheus":  dataSources["Org Prometheus"],
+			"Team Prometheus": dataSources["Team Prometheus"],
+		}
+		assert.Empty(t, hs.getPreferredDefaultDataSource(hs.getFrontendPreferences(context.Background(), signedInUser), withoutUserDS))
+	})
+
+	t.Run("failing preferences query falls back to the org default", func(t *testing.T) {
+		failingHS := &HTTPServer{
+			Cfg:               cfg,
+			preferenceService: &preftest.FakePreferenceService{ExpectedError: errors.New("database is locked")},
+			log:               log.NewNopLogger(),
+		}
+
+		preferences := failingHS.getFrontendPreferences(context.Background(), signedInUser)
+		assert.Nil(t, preferences)
+		assert.Empty(t, failingHS.getPreferredDefaultDataSource(preferences, dataSources))
+	})
+}
+
+func TestHTTPServer_getLocaleSettings(t *testing.T) {
+	staticRoot := t.TempDir()
+	for _, locale := range []string{"en-US", "fr-FR", "pseudo-LOCALE"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(staticRoot, "locales", locale), 0750))
+		require.NoError(t, os.WriteFile(filepath.Join(staticRoot, "locales", locale, "grafana.json"), []byte("{}"), 0600))
+	}
+	// directory without a bundle is not an installed locale
+	require.NoError(t, os.MkdirAll(filepath.Join(staticRoot, "locales", "de-DE"), 0750))
+
+	cfg := setting.NewCfg()
+	cfg.StaticRootPath = staticRoot
+	cfg.Env = setting.Prod
+	sqlStore := db.InitTestDB(t)
+	hs := &HTTPServer{
+		Cfg:               cfg,
+		preferenceService: prefimpl.ProvideService(sqlStore, cfg, featuremgmt.WithFeatures()),
+		locales:           findLocales(cfg, log.NewNopLogger()),
+	}
+
+	signedInUser := &user.SignedInUser{UserID: 1, OrgID: 1}
+
+	t.Run("user preferred locale is the default", func(t *testing.T) {
+		err := hs.preferenceService.Save(context.Background(), &pref.SavePreferenceCommand{OrgID: 1, UserID: 1, Language: "fr-FR"})
+		require.NoError(t, err)
+
+		available, defaultLocale := hs.getLocaleSettings(hs.getFrontendPreferences(context.Background(), signedInUser))
+		assert.Equal(t, []string{"en-US", "fr-FR"}, available)
+		assert.Equal(t, "fr-FR", defaultLocale)
+	})
+
+	t.Run("falls back to English when the preferred locale is not installed", func(t *testing.T) {
+		err := hs.preferenceService.Save(context.Background(), &pref.SavePreferenceCommand{OrgID: 1, UserID: 1, Language: "de-DE"})
+		require.NoError(t, err)
+
+		available, defaultLocale := hs.getLocaleSettings(hs.getFrontendPreferences(context.Background(), signedInUser))
+		assert.Equal(t, []string{"en-US", "fr-FR"}, available)
+		assert.Equal(t, "en-US", defaultLocale)
+	})
+
+	t.Run("falls back to an installed locale when English is not installed", func(t *testing.T) {
+		locales := hs.locales
+		hs.locales = []string{"fr-FR"}
+		t.Cleanup(func() { hs.locales = locales })
+
+		_, defaultLocale := hs.getLocaleSettings(hs.getFrontendPreferences(context.Background(), signedInUser))
+		assert.Equal(t, "fr-FR", defaultLocale)
+	})
+
+	t.Run("falls back to English when the preferences can't be loaded", func(t *testing.T) {
+		available, defaultLocale := hs.getLocaleSettings(nil)
+		assert.Equal(t, []string{"en-US", "fr-FR"}, available)
+		assert.Equal(t, "en-US", defaultLocale)
+	})
+}
+
+func TestFindLocales(t *testing.T) {
+	staticRoot := t.TempDir()
+	for _, locale := range []string{"en-US", "pseudo-LOCALE"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(staticRoot, "locales", locale), 0750))
+		require.NoError(t, os.WriteFile(filepath.Join(staticRoot, "locales", locale, "grafana.json"), []byte("{}"), 0600))
+	}
+
+	cfg := setting.NewCfg()
+	cfg.StaticRootPath = staticRoot
+
+	t.Run("pseudo locale is only available in development", func(t *testing.T) {
+		cfg.Env = setting.Prod
+		assert.Equal(t, []string{"en-US"}, findLocales(cfg, log.NewNopLogger()))
+
+		cfg.Env = setting.Dev
+		assert.Equal(t, []string{"en-US", "pseudo-LOCALE"}, findLocales(cfg, log.NewNopLogger()))
+	})
+
+	t.Run("missing locales directory", func(t *testing.T) {
+		cfg.StaticRootPath = t.TempDir()
+		assert.Equal(t, []string{}, findLocales(cfg, log.NewNopLogger()))
+	})
+}
+
+func TestHTTPServer_GetFrontendSettings_datasources(t *testing.T) {
+	pluginStore := &pluginstore.FakePluginStore{
+		PluginList: []pluginstore.Plugin{
+			{JSONData: plugins.JSONData{ID: "loki", Type: plugins.TypeDataSource}},
+			{JSONData: plugins.JSONData{ID: "grafana", Name: "-- Grafana --", Type: plugins.TypeDataSource, BuiltIn: true}},
+		},
+	}
+	cfg := setting.NewCfg()
+	_, hs := setupTestEnvironment(t, cfg, featuremgmt.WithFeatures(), pluginStore, nil)
+	hs.log = log.NewNopLogger()
+	hs.dsGuardian = guardian.ProvideGuardian()
+	hs.DataSourcesService = &fakeDatasources.FakeDataSourceService{
+		DataSources: []*datasources.DataSource{
+			{ID: 1, UID: "provisioned", OrgID: 1, Name: "Provisioned", Type: "loki", Access: datasources.DS_ACCESS_PROXY, IsDefault: true, ReadOnly: true},
+			{ID: 2, UID: "editable", OrgID: 1, Name: "Editable", Type: "loki", Access: datasources.DS_ACCESS_PROXY},
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/frontend/settings", nil)
+	c := &contextmodel.ReqContext{
+		Context:      &web.Context{Req: req},
+		SignedInUser: &user.SignedInUser{UserID: 1, OrgID: 1, OrgRole: org.RoleViewer},
+		IsSignedIn:   true,
+		Logger:       log.NewNopLogger(),
+	}
+
+	settings, err := hs.getFrontendSettings(c)
+	require.NoError(t, err)
+
+	assert.Equal(t, "Provisioned", settings.DefaultDatasource)
+
+	provisioned := settings.Datasources["Provisioned"]
+	assert.True(t, provisioned.IsDefault)
+	assert.True(t, provisioned.ReadOnly)
+
+	editable := settings.Datasources["Editable"]
+	assert.False(t, editable.IsDefault)
+	assert.False(t, editable.ReadOnly)
+
+	builtIn := settings.Datasources["-- Grafana --"]
+	assert.False(t, builtIn.IsDefault)
+	assert.True(t, builtIn.ReadOnly)
+}
+
+func TestHTTPServer_getSecretsManagerSettings(t *testing.T) {
+	cfg := setting.NewCfg()
+	_, hs := setupTestEnvironment(t, cfg, featuremgmt.WithFeatures(featuremgmt.FlagDisableSecretsCompatibility), nil, nil)
+	hs.log = log.NewNopLogger()
+	hs.secretsPluginManager = secretskvs.NewFakeSecretsPluginManager(t, false)
+
+	ctx := context.Background()
+	hs.secretsPluginManager.SecretsManager(ctx).ID = "test-secretsmanager"
+
+	t.Run("migration status defaults to not started", func(t *testing.T) {
+		settings := hs.getSecretsManagerSettings(ctx, true, false)
+		assert.Equal(t, secretskvs.MigrationStatusNotStarted, secretskvs.MigrationStatus(settings.MigrationStatus))
+	})
+
+	err := secretskvs.SetPluginMigrationStatus(ctx, secretskvs.GetNamespacedKVStore(hs.kvStore), secretskvs.MigrationStatusInProgress)
+	require.NoError(t, err)
+
+	t.Run("server admins get the full block", func(t *testing.T) {
+		settings := hs.getSecretsManagerSettings(ctx, true, true)
+		backwardsCompatibilityEnabled := false
+		assert.Equal(t, dtos.FrontendSettingsSecretsManagerDTO{
+			Enabled:                       true,
+			PluginID:                      "test-secretsmanager",
+			MigrationStatus:               "in_progress",
+			BackwardsCompatibilityEnabled: &backwardsCompatibilityEnabled,
+		}, settings)
+	})
+
+	t.Run("other users only learn whether the plugin is enabled", func(t *testing.T) {
+		settings := hs.getSecretsManagerSettings(ctx, false, true)
+		assert.Equal(t, dtos.FrontendSettingsSecretsManagerDTO{Enabled: true}, settings)
+	})
+}
+
+func TestHTTPServer_getAngularPlugins(t *testing.T) {
+	pluginStore := &pluginstore.FakePluginStore{
+		PluginList: []pluginstore.Plugin{
+			{JSONData: plugins.JSONData{ID: "angular-panel", Type: plugins.TypePanel}, Angular: plugins.AngularMeta{Detected: true}},
+			{JSONData: plugins.JSONData{ID: "react-panel", Type: plugins.TypePanel}},
+		},
+	}
+	cfg := setting.NewCfg()
+	_, hs := setupTestEnvironment(t, cfg, featuremgmt.WithFeatures(), pluginStore, nil)
+
+	reqContext := func(role org.RoleType) *contextmodel.ReqContext {
+		return &contextmodel.ReqContext{
+			Context:      &web.Context{Req: httptest.NewRequest(http.MethodGet, "/api/frontend/settings", nil)},
+			SignedInUser: &user.SignedInUser{UserID: 1, OrgID: 1, OrgRole: role},
+			IsSignedIn:   true,
+		}
+	}
+
+	t.Run("admin sees the angular plugins", func(t *testing.T) {
+		assert.Equal(t, []string{"angular-panel"}, hs.getAngularPlugins(reqContext(org.RoleAdmin)))
+	})
+
+	t.Run("viewer gets an empty list", func(t *testing.T) {
+		assert.Equal(t, []string{}, hs.getAngularPlugins(reqContext(org.RoleViewer)))
+	})
+}
+
+func TestHTTPServer_validateFooterLinks(t *testing.T) {
+	hs := &HTTPServer{log: log.NewNopLogger()}
+
+	t.Run("links with javascript or relative urls are dropped", func(t *testing.T) {
+		links := hs.validateFooterLinks([]dtos.FrontendSettingsFooterConfigItemDTO{
+			{Text: "XSS", Url: "javascript:alert(1)", Icon: "link"},
+			{Text: "Relative", Url: "/support", Icon: "link"},
+			{Text: "Support", Url: "https://support.example.com", Icon: "link"},
+			{Text: "Mail", Url: "mailto:support@example.com", Icon: "envelope"},
+		})
+		require.Len(t, links, 2)
+		assert.Equal(t, "Support", links[0].Text)
+		assert.Equal(t, "Mail", links[1].Text)
+	})
+
+	t.Run("unknown or missing icons fall back to the default icon", func(t *testing.T) {
+		links := hs.validateFooterLinks([]dtos.FrontendSettingsFooterConfigItemDTO{
+			{Text: "Unknown", Url: "https://example.com", Icon: "not-an-icon"},
+			{Text: "Missing", Url: "https://example.com"},
+		})
+		require.Len(t, links, 2)
+		assert.Equal(t, setting.DefaultFooterLinkIcon, links[0].Icon)
+		assert.Equal(t, setting.DefaultFooterLinkIcon, links[1].Icon)
+	})
+
+	t.Run("the number of links is capped", func(t *testing.T) {
+		links := make([]dtos.FrontendSettingsFooterConfigItemDTO, setting.MaxFooterLinks+5)
+		for i := range links {
+			links[i] = dtos.FrontendSettingsFooterConfigItemDTO{Text: "Link", Url: "https://example.com", Icon: "link"}
+		}
+		assert.Len(t, hs.validateFooterLinks(links), setting.MaxFooterLinks)
+	})
+}
+
+func TestHTTPServer_GetFrontendSettings_extensions(t *testing.T) {
+	type settings struct {
+		Extensions map[string]json.RawMessage `json:"extensions"`
+	}
+
+	cfg := setting.NewCfg()
+	m, hs := setupTestEnvironment(t, cfg, featuremgmt.WithFeatures(), nil, nil)
+	require.NoError(t, hs.HooksService.AddFrontendSettingsExtension("grafana-reporting-app", func(*contextmodel.ReqContext) (json.RawMessage, error) {
+		return json.RawMessage(`{"enabled":true}`), nil
+	}))
+	require.NoError(t, hs.HooksService.AddFrontendSettingsExtension("acme.branding", func(*contextmodel.ReqContext) (json.RawMessage, error) {
+		return json.RawMessage(`{"theme":"dark"}`), nil
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/frontend/settings", nil)
+	recorder := httptest.NewRecorder()
+	m.ServeHTTP(recorder, req)
+	var got settings
+	require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+
+	require.Equal(t, http.StatusOK, recorder.Code)
+	assert.JSONEq(t, `{"enabled":true}`, string(got.Extensions["grafana-reporting-app"]))
+	assert.JSONEq(t, `{"theme":"dark"}`, string(got.Extensions["acme.branding"]))
+}
+
+func TestHTTPServer_getNavigationSettings(t *testing.T) {
+	cfg := setting.NewCfg()
+	cfg.Navigation = setting.NavigationSettings{
+		HiddenSections: []string{"alerting", "cfg"},
+		PinnedItems:    []string{"grafana-k8s-app"},
+	}
+	_, hs := setupTestEnvironment(t, cfg, featuremgmt.WithFeatures(), nil, nil)
+
+	err := hs.preferenceService.Patch(context.Background(), &pref.PatchPreferenceCommand{
+		OrgID:      2,
+		Navigation: &pref.NavigationPreference{PinnedItems: []string{"grafana-oncall-app"}},
+	})
+	require.NoError(t, err)
+	err = hs.preferenceService.Patch(context.Background(), &pref.PatchPreferenceCommand{
+		OrgID:      3,
+		Navigation: &pref.NavigationPreference{HiddenSections: []string{}},
+	})
+	require.NoError(t, err)
+
+	t.Run("org without overrides uses the instance settings", func(t *testing.T) {
+		got := hs.getNavigationSettings(context.Background(), &user.SignedInUser{OrgID: 1, OrgRole: org.RoleViewer})
+		assert.Equal(t, []string{"alerting", "cfg"}, got.HiddenSections)
+		assert.Equal(t, []string{"grafana-k8s-app"}, got.PinnedItems)
+	})
+
+	t.Run("org overrides replace the instance settings they set", func(t *testing.T) {
+		got := hs.getNavigationSettings(context.Background(), &user.SignedInUser{OrgID: 2, OrgRole: org.RoleViewer})
+		assert.Equal(t, []string{"alerting", "cfg"}, got.HiddenSections)
+		assert.Equal(t, []string{"grafana-oncall-app"}, got.PinnedItems)
+	})
+
+	t.Run("org can clear the instance hidden sections", func(t *testing.T) {
+		got := hs.getNavigationSettings(context.Background(), &user.SignedInUser{OrgID: 3, OrgRole: org.RoleViewer})
+		assert.Equal(t, []string{}, got.HiddenSections)
+		assert.Equal(t, []string{"grafana-k8s-app"}, got.PinnedItems)
+	})
+
+	t.Run("server admins always see the administration section", func(t *testing.T) {
+		got := hs.getNavigationSettings(context.Background(), &user.SignedInUser{OrgID: 1, OrgRole: org.RoleViewer, IsGrafanaAdmin: true})
+		assert.Equal(t, []string{"alerting"}, got.HiddenSections)
+	})
+
+	t.Run("org admins can't see the hidden administration section", func(t *testing.T) {
+		got := hs.getNavigationSettings(context.Background(), &user.SignedInUser{OrgID: 1, OrgRole: org.RoleAdmin})
+		assert.Equal(t, []string{"alerting", "cfg"}, got.HiddenSections)
+	})
+}
+
+func TestHTTPServer_GetFrontendSettings_serverTime(t *testing.T) {
+	type settings struct {
+		ServerTimeMillis int64  `json:"serverTimeMillis"`
+		ServerTimezone   string `json:"serverTimezone"`
+	}
+
+	cfg := setting.NewCfg()
+	m, _ := setupTestEnvironment(t, cfg, featuremgmt.WithFeatures(), nil, nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/frontend/settings", nil)
+
+	before := time.Now().UnixMilli()
+	recorder := httptest.NewRecorder()
+	m.ServeHTTP(recorder, req)
+	after := time.Now().UnixMilli()
+
+	var got settings
+	err := json.Unmarshal(recorder.Body.Bytes(), &got)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.GreaterOrEqual(t, got.ServerTimeMillis, before)
+	require.LessOrEqual(t, got.ServerTimeMillis, after)
+
+	require.NotEmpty(t, got.ServerTimezone)
+	_, err = time.LoadLocation(got.ServerTimezone)
+	require.NoError(t, err)
+}
+
+func TestLocalTimezoneName(t *testing.T) {
+	dir := t.TempDir()
+	localtime := filepath.Join(dir, "localtime")
+	require.NoError(t, os.Symlink("/usr/share/zoneinfo/Europe/Stockholm", localtime))
+
+	t.Run("TZ environment variable takes precedence", func(t *testing.T) {
+		assert.Equal(t, "America/New_York", localTimezoneName("America/New_York", localtime))
+		assert.Equal(t, "Asia/Tokyo", localTimezoneName(":Asia/Tokyo", localtime))
+	})
+
+	t.Run("localtime symlink is used without TZ", func(t *testing.T) {
+		assert.Equal(t, "Europe/Stockholm", localTimezoneName("", localtime))
+	})
+
+	t.Run("unknown timezones fall back to UTC", func(t *testing.T) {
+		assert.Equal(t, "UTC", localTimezoneName("Not/AZone", filepath.Join(dir, "missing")))
+	})
+}
+
+func TestHTTPServer_GetFrontendSettings_avatars(t *testing.T) {
+	type settings struct {
+		Avatars dtos.FrontendSettingsAvatarsDTO `json:"avatars"`
+	}
+
+	disableGravatar, avatarSourceURL := setting.DisableGravatar, setting.AvatarSourceURL
+	t.Cleanup(func() {
+		setting.DisableGravatar, setting.AvatarSourceURL = disableGravatar, avatarSourceURL
+	})
+	setting.DisableGravatar = true
+	setting.AvatarSourceURL = "https://avatars.example.com/api/{hash}.png"
+
+	cfg := setting.NewCfg()
+	cfg.AppSubURL = "/grafana"
+	m, _ := setupTestEnvironment(t, cfg, featuremgmt.WithFeatures(), nil, nil)
+	req := httptest.NewRequest(http.MethodGet, "/api/frontend/settings", nil)
+
+	recorder := httptest.NewRecorder()
+	m.ServeHTTP(recorder, req)
+	var got settings
+	err := json.Unmarshal(recorder.Body.Bytes(), &got)
+	require.NoError(t, err)
+	require.Equal(t, http.StatusOK, recorder.Code)
+	require.Equal(t, dtos.FrontendSettingsAvatarsDTO{
+		GravatarEnabled:  false,
+		DefaultAvatarUrl: "/grafana/public/img/user_profile.png",
+		CustomSourceUrl:  "https://avatars.example.com/api/{hash}.png",
+	}, got.Avatars)
+}
+
+type fakeRenderService struct {
+	rendering.Service
+	available    bool
+	version      string
+	capabilities map[rendering.CapabilityName]bool
+}
+
+func (s *fakeRenderService) IsAvailable(_ context.Context) bool {
+	return s.available
+}
+
+func (s *fakeRenderService) Version() string {
+	return s.version
+}
+
+func (s *fakeRenderService) HasCapability(_ context.Context, capability rendering.CapabilityName) (rendering.CapabilitySupportRequestResult, error) {
+	if !s.available {
+		return rendering.CapabilitySupportRequestResult{}, rendering.ErrRenderUnavailable
+	}
+	return rendering.CapabilitySupportRequestResult{IsSupported: s.capabilities[capability]}, nil
+}
+
+func TestHTTPServer_GetFrontendSettings_rendering(t *testing.T) {
+	type settings struct {
+		RendererAvailable bool                              `json:"rendererAvailable"`
+		RendererVersion   string                            `json:"rendererVersion"`
+		Rendering         dtos.FrontendSettingsRenderingDTO `json:"rendering"`
+	}
+
+	tests := []struct {
+		desc          string
+		renderService *fakeRenderService
+		expected      settings
+	}{
+		{
+			desc: "available renderer reports its formats",
+			renderService: &fakeRenderService{
+				available:    true,
+				version:      "3.10.0",
+				capabilities: map[rendering.CapabilityName]bool{rendering.PDFRendering: true, rendering.CSVRendering: true},
+			},
+			expected: settings{
+				RendererAvailable: true,
+				RendererVersion:   "3.10.0",
+				Rendering: dtos.FrontendSettingsRenderingDTO{
+					Available:             true,
+					Version:               "3.10.0",
+					SupportedFormats:      []string{"png", "pdf", "csv"},
+					ConcurrencyLimit:      5,
+					DefaultTimeoutSeconds: 60,
+				},
+			},
+		},
+		{
+			desc:          "no renderer",
+			renderService: &fakeRenderService{},
+			expected: settings{
+				Rendering: dtos.FrontendSettingsRenderingDTO{
+					SupportedFormats:      []string{},
+					ConcurrencyLimit:      5,
+					DefaultTimeoutSeconds: 60,
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := setting.NewCfg()
+			cfg.RendererConcurrentRequestLimit = 5
+			m, hs := setupTestEnvironment(t, cfg, featuremgmt.WithFeatures(), nil, nil)
+			hs.RenderService = test.renderService
+			req := httptest.NewRequest(http.MethodGet, "/api/frontend/settings", nil)
+
+			recorder := httptest.NewRecorder()
+			m.ServeHTTP(recorder, req)
+			var got settings
+			err := json.Unmarshal(recorder.Body.Bytes(), &got)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, recorder.Code)
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestHTTPServer_GetFrontendSettings_orgContext(t *testing.T) {
+	type settings struct {
+		Namespace             string `json:"namespace"`
+		OrgId                 int64  `json:"orgId"`
+		OrgName               string `json:"orgName"`
+		UnifiedStorageEnabled bool   `json:"unifiedStorageEnabled"`
+	}
+
+	cfg := setting.NewCfg()
+	m, hs := setupTestEnvironment(t, cfg, featuremgmt.WithFeatures(), nil, nil)
+	hs.dsGuardian = guardian.ProvideGuardian()
+	hs.DataSourcesService = &fakeDatasources.FakeDataSourceService{}
+
+	signedInUser := &user.SignedInUser{UserID: 1, OrgRole: org.RoleViewer}
+	m.Get("/api/test/frontend/settings", func(c *contextmodel.ReqContext) {
+		c.SignedInUser = signedInUser
+		hs.GetFrontendSettings(c)
+	})
+
+	getSettings := func(t *testing.T) settings {
+		t.Helper()
+		recorder := httptest.NewRecorder()
+		m.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/test/frontend/settings", nil))
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		var got settings
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+		return got
+	}
+
+	t.Run("user switching orgs gets the settings of the current org", func(t *testing.T) {
+		signedInUser.OrgID, signedInUser.OrgName = 1, "Main Org."
+		require.Equal(t, settings{Namespace: "default", OrgId: 1, OrgName: "Main Org."}, getSettings(t))
+
+		signedInUser.OrgID, signedInUser.OrgName = 2, "Ops"
+		require.Equal(t, settings{Namespace: "org-2", OrgId: 2, OrgName: "Ops"}, getSettings(t))
+	})
+
+	t.Run("namespace is the stack for every org in cloud", func(t *testing.T) {
+		cfg.StackID = "123"
+		t.Cleanup(func() { cfg.StackID = "" })
+
+		signedInUser.OrgID, signedInUser.OrgName = 2, "Ops"
+		require.Equal(t, settings{Namespace: "stack-123", OrgId: 2, OrgName: "Ops"}, getSettings(t))
+	})
+
+	t.Run("unified storage state", func(t *testing.T) {
+		signedInUser.OrgID, signedInUser.OrgName = 1, "Main Org."
+		require.False(t, getSettings(t).UnifiedStorageEnabled)
+
+		hs.unifiedStorageEnabled = true
+		t.Cleanup(func() { hs.unifiedStorageEnabled = false })
+		require.True(t, getSettings(t).UnifiedStorageEnabled)
+	})
+
+	t.Run("request without an org", func(t *testing.T) {
+		signedInUser.OrgID, signedInUser.OrgName = 0, ""
+		require.Equal(t, settings{}, getSettings(t))
+	})
+}
+
+func TestHTTPServer_GetFrontendSettings_analyticsKeys(t *testing.T) {
+	type settings struct {
+		GoogleAnalyticsId                   string `json:"googleAnalyticsId"`
+		GoogleAnalytics4Id                  string `json:"googleAnalytics4Id"`
+		RudderstackWriteKey                 string `json:"rudderstackWriteKey"`
+		ApplicationInsightsConnectionString string `json:"applicationInsightsConnectionString"`
+	}
+	keys := settings{
+		GoogleAnalyticsId:                   "UA-1234",
+		GoogleAnalytics4Id:                  "G-1234",
+		RudderstackWriteKey:                 "write-key",
+		ApplicationInsightsConnectionString: "InstrumentationKey=1234",
+	}
+
+	tests := []struct {
+		desc             string
+		analyticsEnabled bool
+		requireEnabled   bool
+		hideFromAnon     bool
+		signedIn         bool
+		expected         settings
+	}{
+		{desc: "keys are sent by default", expected: keys},
+		{desc: "keys are sent when analytics are enabled", analyticsEnabled: true, requireEnabled: true, expected: keys},
+		{desc: "keys are empty when analytics are disabled", requireEnabled: true, expected: settings{}},
+		{desc: "keys are empty for anonymous users", analyticsEnabled: true, hideFromAnon: true, expected: settings{}},
+		{desc: "keys are sent to signed in users", analyticsEnabled: true, hideFromAnon: true, signedIn: true, expected: keys},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := setting.NewCfg()
+			cfg.GoogleAnalyticsID = keys.GoogleAnalyticsId
+			cfg.GoogleAnalytics4ID = keys.GoogleAnalytics4Id
+			cfg.RudderstackWriteKey = keys.RudderstackWriteKey
+			cfg.ApplicationInsightsConnectionString = keys.ApplicationInsightsConnectionString
+			cfg.AnalyticsKeysRequireEnabled = test.requireEnabled
+			cfg.AnalyticsKeysHideFromAnonymous = test.hideFromAnon
+			_, err := cfg.Raw.Section("analytics").NewKey("enabled", strconv.FormatBool(test.analyticsEnabled))
+			require.NoError(t, err)
+
+			m, hs := setupTestEnvironment(t, cfg, featuremgmt.WithFeatures(), nil, nil)
+			m.Get("/api/test/frontend/settings", func(c *contextmodel.ReqContext) {
+				c.IsSignedIn = test.signedIn
+				hs.GetFrontendSettings(c)
+			})
+
+			recorder := httptest.NewRecorder()
+			m.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/test/frontend/settings", nil))
+			var got settings
+			err = json.Unmarshal(recorder.Body.Bytes(), &got)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, recorder.Code)
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func TestHTTPServer_GetFrontendSettings_enabledForRoles(t *testing.T) {
+	type settings struct {
+		ApplicationInsightsConnectionString   string                         `json:"applicationInsightsConnectionString"`
+		ApplicationInsightsSamplingPercentage float64                        `json:"applicationInsightsSamplingPercentage"`
+		GrafanaJavascriptAgent                setting.GrafanaJavascriptAgent `json:"grafanaJavascriptAgent"`
+	}
+
+	cfg := setting.NewCfg()
+	cfg.ApplicationInsightsConnectionString = "InstrumentationKey=1234"
+	cfg.ApplicationInsightsSamplingPercentage = 25
+	cfg.ApplicationInsightsEnabledForRoles = []string{"Admin"}
+	cfg.GrafanaJavascriptAgent = setting.GrafanaJavascriptAgent{
+		Enabled:         true,
+		CustomEndpoint:  "/log-grafana-javascript-agent",
+		ApiKey:          "api-key",
+		EnabledForRoles: []string{"Admin"},
+	}
+
+	m, hs := setupTestEnvironment(t, cfg, featuremgmt.WithFeatures(), nil, nil)
+	signedInUser := &user.SignedInUser{UserID: 1}
+	m.Get("/api/test/frontend/settings", func(c *contextmodel.ReqContext) {
+		c.SignedInUser = signedInUser
+		hs.GetFrontendSettings(c)
+	})
+
+	getSettings := func(t *testing.T) settings {
+		t.Helper()
+		recorder := httptest.NewRecorder()
+		m.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/api/test/frontend/settings", nil))
+		require.Equal(t, http.StatusOK, recorder.Code)
+
+		var got settings
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), &got))
+		return got
+	}
+
+	t.Run("viewer is excluded", func(t *testing.T) {
+		signedInUser.OrgRole = org.RoleViewer
+		got := getSettings(t)
+		require.Empty(t, got.ApplicationInsightsConnectionString)
+		require.Equal(t, 25.0, got.ApplicationInsightsSamplingPercentage)
+		require.Equal(t, setting.GrafanaJavascriptAgent{}, got.GrafanaJavascriptAgent)
+	})
+
+	t.Run("admin is included", func(t *testing.T) {
+		signedInUser.OrgRole = org.RoleAdmin
+		got := getSettings(t)
+		require.Equal(t, "InstrumentationKey=1234", got.ApplicationInsightsConnectionString)
+		require.Equal(t, 25.0, got.ApplicationInsightsSamplingPercentage)
+		require.True(t, got.GrafanaJavascriptAgent.Enabled)
+		require.Equal(t, "api-key", got.GrafanaJavascriptAgent.ApiKey)
+	})
+
+	t.Run("all roles are included by default", func(t *testing.T) {
+		cfg.ApplicationInsightsEnabledForRoles = []string{}
+		cfg.GrafanaJavascriptAgent.EnabledForRoles = nil
+		signedInUser.OrgRole = org.RoleViewer
+		got := getSettings(t)
+		require.Equal(t, "InstrumentationKey=1234", got.ApplicationInsightsConnectionString)
+		require.True(t, got.GrafanaJavascriptAgent.Enabled)
+	})
+}
+
+func TestHTTPServer_GetFrontendSettings_degradedMode(t *testing.T) {
+	type settings struct {
+		BuildInfo struct {
+			DegradedMode    bool     `json:"degradedMode"`
+			DegradedReasons []string `json:"degradedReasons"`
+		} `json:"buildInfo"`
+	}
+
+	tests := []struct {
+		desc            string
+		dbHealthy       bool
+		pauseAlerting   bool
+		expectedReasons []string
+	}{
+		{
+			desc:            "healthy",
+			dbHealthy:       true,
+			expectedReasons: []string{},
+		},
+		{
+			desc:            "database unreachable",
+			dbHealthy:       false,
+			expectedReasons: []string{"database is unreachable"},
+		},
+		{
+			desc:            "alerting paused",
+			dbHealthy:       true,
+			pauseAlerting:   true,
+			expectedReasons: []string{"alert rule evaluation is paused"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			cfg := setting.NewCfg()
+			alertingEnabled := true
+			cfg.UnifiedAlerting.Enabled = &alertingEnabled
+			cfg.UnifiedAlerting.ExecuteAlerts = !test.pauseAlerting
+			m, hs := setupTestEnvironment(t, cfg, featuremgmt.WithFeatures(), nil, nil)
+			hs.CacheService.Set("db-healthy", test.dbHealthy, time.Minute)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/frontend/settings", nil)
+			recorder := httptest.NewRecorder()
+			m.ServeHTTP(recorder, req)
+
+			var got settings
+			err := json.Unmarshal(recorder.Body.Bytes(), &got)
+			require.NoError(t, err)
+			require.Equal(t, http.StatusOK, recorder.Code)
+			require.Equal(t, len(test.expectedReasons) > 0, got.BuildInfo.DegradedMode)
+			require.Equal(t, test.expectedReasons, got.BuildInfo.DegradedReasons)
+		})
+	}
+}
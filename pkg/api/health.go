@@ -7,10 +7,10 @@ import (
 	"github.com/grafana/grafana/pkg/infra/db"
 )
 
-func (hs *HTTPServer) databaseHealthy(ctx context.Context) bool {
-	const cacheKey = "db-healthy"
+const dbHealthyCacheKey = "db-healthy"
 
-	if cached, found := hs.CacheService.Get(cacheKey); found {
+func (hs *HTTPServer) databaseHealthy(ctx context.Context) bool {
+	if cached, found := hs.CacheService.Get(dbHealthyCacheKey); found {
 		return cached.(bool)
 	}
 
@@ -20,6 +20,72 @@ func (hs *HTTPServer) databaseHealthy(ctx context.Context) bool {
 	})
 	healthy := err == nil
 
-	hs.CacheService.Set(cacheKey, healthy, time.Second*5)
+	hs.CacheService.Set(dbHealthyCacheKey, healthy, time.Second*5)
 	return healthy
 }
+
+// healthProbe checks a single subsystem. It returns a human readable reason
+// when the subsystem is degraded and an empty string when it is healthy.
+// Probes run on every frontend settings request, so they must not block.
+type healthProbe struct {
+	name  string
+	check func(ctx context.Context) string
+}
+
+func (hs *HTTPServer) healthProbes() []healthProbe {
+	return []healthProbe{
+		{
+			name: "database",
+			check: func(ctx context.Context) string {
+				if !hs.cachedDatabaseHealthy() {
+					return "database is unreachable"
+				}
+				return ""
+			},
+		},
+		{
+			name: "alerting",
+			check: func(ctx context.Context) string {
+				if hs.Cfg.UnifiedAlerting.IsEnabled() && !hs.Cfg.UnifiedAlerting.ExecuteAlerts {
+					return "alert rule evaluation is paused"
+				}
+				return ""
+			},
+		},
+	}
+}
+
+// cachedDatabaseHealthy returns the database health cached by databaseHealthy without waiting
+// for the database. When nothing is cached it refreshes the cache in the background, one check
+// at a time, and reports the database as healthy until the check has finished.
+func (hs *HTTPServer) cachedDatabaseHealthy() bool {
+	if cached, found := hs.CacheService.Get(dbHealthyCacheKey); found {
+		return cached.(bool)
+	}
+
+	if hs.dbHealthChecking.CompareAndSwap(false, true) {
+		go func() {
+			defer hs.dbHealthChecking.Store(false)
+			// not bound to the request, so a slow database is cached as unhealthy only when it really fails
+			hs.databaseHealthy(context.Background())
+		}()
+	}
+	return true
+}
+
+// getDegradedReasons runs the health probes and returns the reasons for the
+// subsystems that are degraded. It never returns nil.
+func (hs *HTTPServer) getDegradedReasons(ctx context.Context) []string {
+	return runHealthProbes(ctx, hs.healthProbes())
+}
+
+// runHealthProbes runs the probes and returns the reasons of the degraded ones, in the order of the probes.
+func runHealthProbes(ctx context.Context, probes []healthProbe) []string {
+	reasons := []string{}
+	for _, probe := range probes {
+		if reason := probe.check(ctx); reason != "" {
+			reasons = append(reasons, reason)
+		}
+	}
+	return reasons
+}
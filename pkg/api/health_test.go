@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -187,3 +188,40 @@ func setupHealthAPITestEnvironment(t *testing.T, cbs ...func(*setting.Cfg)) (*we
 	m.Get("/api/health", hs.apiHealthHandler)
 	return m, hs
 }
+
+func TestRunHealthProbes(t *testing.T) {
+	healthy := healthProbe{name: "healthy", check: func(context.Context) string { return "" }}
+	degraded := healthProbe{name: "degraded", check: func(context.Context) string { return "subsystem is down" }}
+
+	t.Run("all probes healthy", func(t *testing.T) {
+		reasons := runHealthProbes(context.Background(), []healthProbe{healthy, healthy})
+		require.NotNil(t, reasons)
+		require.Empty(t, reasons)
+	})
+
+	t.Run("degraded probe reports its reason", func(t *testing.T) {
+		reasons := runHealthProbes(context.Background(), []healthProbe{healthy, degraded})
+		require.Equal(t, []string{"subsystem is down"}, reasons)
+	})
+}
+
+func TestHTTPServer_cachedDatabaseHealthy(t *testing.T) {
+	t.Run("uses the cached database health", func(t *testing.T) {
+		_, hs := setupHealthAPITestEnvironment(t)
+		hs.CacheService.Set(dbHealthyCacheKey, false, time.Minute)
+
+		require.False(t, hs.cachedDatabaseHealthy())
+	})
+
+	t.Run("refreshes the cache in the background without waiting for the database", func(t *testing.T) {
+		_, hs := setupHealthAPITestEnvironment(t)
+		hs.SQLStore.(*dbtest.FakeDB).ExpectedError = errors.New("bad")
+
+		require.True(t, hs.cachedDatabaseHealthy())
+		require.Eventually(t, func() bool {
+			healthy, found := hs.CacheService.Get(dbHealthyCacheKey)
+			return found && !healthy.(bool)
+		}, time.Second, 10*time.Millisecond)
+		require.False(t, hs.cachedDatabaseHealthy())
+	})
+}
@@ -12,6 +12,7 @@ import (
 	"path/filepath"
 	"strings"
 	"sync"
+	"sync/atomic"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -111,6 +112,9 @@ type HTTPServer struct {
 	httpSrv          *http.Server
 	middlewares      []web.Handler
 	namedMiddlewares []routing.RegisterNamedMiddleware
+	apiVersionHash   string
+	locales          []string
+	dbHealthChecking atomic.Bool
 	bus              bus.Bus
 
 	pluginContextProvider        *plugincontext.Provider
@@ -191,6 +195,7 @@ type HTTPServer struct {
 	apiKeyService                apikey.Service
 	kvStore                      kvstore.KVStore
 	pluginsCDNService            *pluginscdn.Service
+	unifiedStorageEnabled        bool
 
 	userService          user.Service
 	tempUserService      tempUser.Service
@@ -352,6 +357,13 @@ func ProvideHTTPServer(opts ServerOptions, cfg *setting.Cfg, routeRegister routi
 	if hs.Listener != nil {
 		hs.log.Debug("Using provided listener")
 	}
+	for _, id := range cfg.Navigation.HiddenSections {
+		if !navtree.IsSectionID(id) {
+			hs.log.Warn("Unknown navigation section in hidden_sections, it won't hide anything", "section", id)
+		}
+	}
+	hs.locales = findLocales(cfg, hs.log)
+	hs.unifiedStorageEnabled = grafanaapiserver.IsUnifiedStorageEnabled(cfg)
 	hs.registerRoutes()
 
 	// Register access control scope resolver for annotations
@@ -562,7 +574,9 @@ func (hs *HTTPServer) applyRoutes() {
 	// start with middlewares & static routes
 	hs.addMiddlewaresAndStaticRoutes()
 	// then add view routes & api routes
-	hs.RouteRegister.Register(hs.web, hs.namedMiddlewares...)
+	recorder := &routeRecorder{Router: hs.web}
+	hs.RouteRegister.Register(recorder, hs.namedMiddlewares...)
+	hs.apiVersionHash = computeAPIVersionHash(hs.Cfg.BuildVersion+"-"+hs.Cfg.BuildCommit, recorder.routes)
 	// lastly not found route
 	hs.web.NotFound(middleware.ProvideRouteOperationName("notfound"), middleware.ReqSignedIn, hs.NotFoundHandler)
 }
@@ -596,6 +610,7 @@ func (hs *HTTPServer) addMiddlewaresAndStaticRoutes() {
 	}
 
 	m.Use(middleware.AddDefaultResponseHeaders(hs.Cfg))
+	m.Use(hs.apiVersionHashHandler)
 
 	if hs.Cfg.ServeFromSubPath && hs.Cfg.AppSubURL != "" {
 		m.SetURLPrefix(hs.Cfg.AppSubURL)
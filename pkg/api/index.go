@@ -156,7 +156,7 @@ func (hs *HTTPServer) setIndexViewData(c *contextmodel.ReqContext) (*dtos.IndexV
 
 	data.User.Permissions = ac.BuildPermissionsMap(userPermissions)
 
-	if setting.DisableGravatar {
+	if !setting.AvatarProxyEnabled() {
 		data.User.GravatarUrl = hs.Cfg.AppSubURL + "/public/img/user_profile.png"
 	}
 
@@ -166,6 +166,10 @@ func (hs *HTTPServer) setIndexViewData(c *contextmodel.ReqContext) (*dtos.IndexV
 
 	hs.HooksService.RunIndexDataHooks(&data, c)
 
+	if data.Settings != nil && data.Settings.Whitelabeling != nil {
+		data.Settings.Whitelabeling.Links = hs.validateFooterLinks(data.Settings.Whitelabeling.Links)
+	}
+
 	data.NavTree.ApplyAdminIA(hs.Cfg.IsFeatureToggleEnabled(featuremgmt.FlagNavAdminSubsections))
 	data.NavTree.Sort()
 
@@ -125,8 +125,8 @@ func (hs *HTTPServer) AddOrgInvite(c *contextmodel.ReqContext) response.Response
 		return response.Error(http.StatusInternalServerError, "Failed to save invite to database", err)
 	}
 
-	// send invite email
-	if inviteDto.SendEmail && util.IsEmail(inviteDto.LoginOrEmail) {
+	// send invite email, without SMTP the invite can only be shared through its link
+	if inviteDto.SendEmail && util.IsEmail(inviteDto.LoginOrEmail) && hs.Cfg.Smtp.Enabled {
 		emailCmd := notifications.SendEmailCommand{
 			To:       []string{inviteDto.LoginOrEmail},
 			Template: "new_user_invite",
@@ -168,7 +168,7 @@ func (hs *HTTPServer) inviteExistingUserToOrg(c *contextmodel.ReqContext, user *
 		return response.Error(http.StatusInternalServerError, "Error while trying to create org user", err)
 	}
 
-	if inviteDto.SendEmail && util.IsEmail(user.Email) {
+	if inviteDto.SendEmail && util.IsEmail(user.Email) && hs.Cfg.Smtp.Enabled {
 		emailCmd := notifications.SendEmailCommand{
 			To:       []string{user.Email},
 			Template: "invited_to_org",
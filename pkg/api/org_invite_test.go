@@ -9,6 +9,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/grafana/grafana/pkg/services/accesscontrol"
+	"github.com/grafana/grafana/pkg/services/ngalert"
+	"github.com/grafana/grafana/pkg/services/notifications"
 	"github.com/grafana/grafana/pkg/services/org/orgtest"
 	"github.com/grafana/grafana/pkg/services/user"
 	"github.com/grafana/grafana/pkg/services/user/usertest"
@@ -75,3 +77,52 @@ func TestOrgInvitesAPIEndpoint_RBAC(t *testing.T) {
 		})
 	}
 }
+
+func TestOrgInvitesAPIEndpoint_SMTP(t *testing.T) {
+	tests := []struct {
+		desc          string
+		smtpEnabled   bool
+		expectedEmail bool
+	}{
+		{
+			desc:          "should add the user without sending an email when SMTP is disabled",
+			smtpEnabled:   false,
+			expectedEmail: false,
+		},
+		{
+			desc:          "should add the user and send an email when SMTP is enabled",
+			smtpEnabled:   true,
+			expectedEmail: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			notificationService := notifications.MockNotificationService()
+			server := SetupAPITestServer(t, func(hs *HTTPServer) {
+				hs.Cfg = setting.NewCfg()
+				hs.Cfg.Smtp.Enabled = tt.smtpEnabled
+				hs.orgService = orgtest.NewOrgServiceFake()
+				hs.userService = &usertest.FakeUserService{
+					ExpectedUser: &user.User{ID: 1, Email: "user@example.com"},
+				}
+				hs.AlertNG = &ngalert.AlertNG{NotificationService: notificationService}
+			})
+
+			body := `{"loginOrEmail": "user@example.com", "role": "Viewer", "sendEmail": true}`
+			req := webtest.RequestWithSignedInUser(server.NewPostRequest("/api/org/invites", strings.NewReader(body)), userWithPermissions(1, []accesscontrol.Permission{
+				{Action: accesscontrol.ActionOrgUsersAdd, Scope: "users:id:1"},
+			}))
+			res, err := server.SendJSON(req)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.StatusCode)
+			require.NoError(t, res.Body.Close())
+
+			if tt.expectedEmail {
+				assert.Equal(t, []string{"user@example.com"}, notificationService.Email.To)
+			} else {
+				assert.Empty(t, notificationService.Email.To)
+			}
+		})
+	}
+}
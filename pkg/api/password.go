@@ -23,6 +23,9 @@ func (hs *HTTPServer) SendResetPasswordEmail(c *contextmodel.ReqContext) respons
 	if hs.Cfg.DisableLoginForm {
 		return response.Error(401, "Not allowed to reset password when login form is disabled", nil)
 	}
+	if hs.Cfg.DisableResetPassword {
+		return response.Error(http.StatusNotFound, "Password reset is disabled", nil)
+	}
 
 	userQuery := user.GetUserByLoginQuery{LoginOrEmail: form.UserOrEmail}
 
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana/pkg/services/user"
+	"github.com/grafana/grafana/pkg/services/user/usertest"
+	"github.com/grafana/grafana/pkg/setting"
+)
+
+func TestPasswordAPIEndpoint_SendResetPasswordEmail(t *testing.T) {
+	tests := []struct {
+		desc                 string
+		disableResetPassword bool
+		expectedCode         int
+	}{
+		{
+			desc:                 "should not find the endpoint when password reset is disabled",
+			disableResetPassword: true,
+			expectedCode:         http.StatusNotFound,
+		},
+		{
+			desc:                 "should accept the request when password reset is enabled",
+			disableResetPassword: false,
+			expectedCode:         http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			server := SetupAPITestServer(t, func(hs *HTTPServer) {
+				hs.Cfg = setting.NewCfg()
+				hs.Cfg.Smtp.Enabled = true
+				hs.Cfg.DisableResetPassword = tt.disableResetPassword
+				hs.userService = &usertest.FakeUserService{ExpectedError: user.ErrUserNotFound}
+			})
+
+			req := server.NewPostRequest("/api/user/password/send-reset-email", strings.NewReader(`{"userOrEmail": "user@example.com"}`))
+			req.Header.Set("Content-Type", "application/json")
+			res, err := server.Send(req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCode, res.StatusCode)
+			require.NoError(t, res.Body.Close())
+		})
+	}
+}
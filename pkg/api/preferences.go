@@ -2,14 +2,18 @@ package api
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/grafana/grafana/pkg/api/dtos"
 	"github.com/grafana/grafana/pkg/api/response"
 	"github.com/grafana/grafana/pkg/kinds/preferences"
+	"github.com/grafana/grafana/pkg/plugins"
 	"github.com/grafana/grafana/pkg/services/auth/identity"
 	contextmodel "github.com/grafana/grafana/pkg/services/contexthandler/model"
 	"github.com/grafana/grafana/pkg/services/dashboards"
+	"github.com/grafana/grafana/pkg/services/navtree"
 	pref "github.com/grafana/grafana/pkg/services/preference"
 	"github.com/grafana/grafana/pkg/services/preference/prefapi"
 	"github.com/grafana/grafana/pkg/web"
@@ -94,8 +98,8 @@ func (hs *HTTPServer) UpdateUserPreferences(c *contextmodel.ReqContext) response
 		return response.Error(http.StatusInternalServerError, "Failed to update user preferences", errID)
 	}
 
-	return prefapi.UpdatePreferencesFor(c.Req.Context(), hs.DashboardService,
-		hs.preferenceService, c.SignedInUser.GetOrgID(), userID, 0, &dtoCmd)
+	return prefapi.UpdatePreferencesFor(c.Req.Context(), hs.DashboardService, hs.DataSourceCache,
+		hs.preferenceService, c.SignedInUser, c.SignedInUser.GetOrgID(), userID, 0, &dtoCmd)
 }
 
 // swagger:route PATCH /user/preferences user_preferences patchUserPreferences
@@ -118,14 +122,23 @@ func (hs *HTTPServer) PatchUserPreferences(c *contextmodel.ReqContext) response.
 		return response.Error(http.StatusInternalServerError, "Failed to update user preferences", errID)
 	}
 
-	return hs.patchPreferencesFor(c.Req.Context(), c.SignedInUser.GetOrgID(), userID, 0, &dtoCmd)
+	return hs.patchPreferencesFor(c.Req.Context(), c.SignedInUser, c.SignedInUser.GetOrgID(), userID, 0, &dtoCmd)
 }
 
-func (hs *HTTPServer) patchPreferencesFor(ctx context.Context, orgID, userID, teamId int64, dtoCmd *dtos.PatchPrefsCmd) response.Response {
+func (hs *HTTPServer) patchPreferencesFor(ctx context.Context, user identity.Requester, orgID, userID, teamId int64, dtoCmd *dtos.PatchPrefsCmd) response.Response {
 	if dtoCmd.Theme != nil && !pref.IsValidThemeID(*dtoCmd.Theme) {
 		return response.Error(http.StatusBadRequest, "Invalid theme", nil)
 	}
 
+	if dtoCmd.DefaultDatasourceUID != nil && *dtoCmd.DefaultDatasourceUID != "" {
+		if userID == 0 && teamId == 0 {
+			return response.Error(http.StatusBadRequest, "Default data source can only be set for users and teams", nil)
+		}
+		if resp := prefapi.ValidateDefaultDatasource(ctx, hs.DataSourceCache, user, *dtoCmd.DefaultDatasourceUID); resp != nil {
+			return resp
+		}
+	}
+
 	// convert dashboard UID to ID in order to store internally if it exists in the query, otherwise take the id from query
 	dashboardID := dtoCmd.HomeDashboardID
 	if dtoCmd.HomeDashboardUID != nil {
@@ -155,6 +168,8 @@ func (hs *HTTPServer) patchPreferencesFor(ctx context.Context, orgID, userID, te
 		Language:          dtoCmd.Language,
 		QueryHistory:      dtoCmd.QueryHistory,
 		CookiePreferences: dtoCmd.Cookies,
+
+		DefaultDatasourceUID: dtoCmd.DefaultDatasourceUID,
 	}
 
 	if err := hs.preferenceService.Patch(ctx, &patchCmd); err != nil {
@@ -193,7 +208,8 @@ func (hs *HTTPServer) UpdateOrgPreferences(c *contextmodel.ReqContext) response.
 		return response.Error(http.StatusBadRequest, "bad request data", err)
 	}
 
-	return prefapi.UpdatePreferencesFor(c.Req.Context(), hs.DashboardService, hs.preferenceService, c.SignedInUser.GetOrgID(), 0, 0, &dtoCmd)
+	return prefapi.UpdatePreferencesFor(c.Req.Context(), hs.DashboardService, hs.DataSourceCache,
+		hs.preferenceService, c.SignedInUser, c.SignedInUser.GetOrgID(), 0, 0, &dtoCmd)
 }
 
 // swagger:route PATCH /org/preferences org_preferences patchOrgPreferences
@@ -211,7 +227,80 @@ func (hs *HTTPServer) PatchOrgPreferences(c *contextmodel.ReqContext) response.R
 	if err := web.Bind(c.Req, &dtoCmd); err != nil {
 		return response.Error(http.StatusBadRequest, "bad request data", err)
 	}
-	return hs.patchPreferencesFor(c.Req.Context(), c.SignedInUser.GetOrgID(), 0, 0, &dtoCmd)
+	return hs.patchPreferencesFor(c.Req.Context(), c.SignedInUser, c.SignedInUser.GetOrgID(), 0, 0, &dtoCmd)
+}
+
+// swagger:route GET /orgs/{org_id}/preferences/navigation orgs getOrgNavigationPreferences
+//
+// Get the navigation customization of an org.
+//
+// Lists that aren't set use the instance settings from the [navigation] section.
+//
+// Responses:
+// 200: getNavigationPreferencesResponse
+// 400: badRequestError
+// 401: unauthorisedError
+// 403: forbiddenError
+// 500: internalServerError
+func (hs *HTTPServer) GetOrgNavigationPreferences(c *contextmodel.ReqContext) response.Response {
+	orgID, err := strconv.ParseInt(web.Params(c.Req)[":orgId"], 10, 64)
+	if err != nil {
+		return response.Error(http.StatusBadRequest, "orgId is invalid", err)
+	}
+
+	preference, err := hs.preferenceService.Get(c.Req.Context(), &pref.GetPreferenceQuery{OrgID: orgID})
+	if err != nil {
+		return response.Error(http.StatusInternalServerError, "Failed to get preferences", err)
+	}
+
+	navigation := pref.NavigationPreference{}
+	if preference.JSONData != nil && preference.JSONData.Navigation != nil {
+		navigation = *preference.JSONData.Navigation
+	}
+	return response.JSON(http.StatusOK, navigation)
+}
+
+// swagger:route PUT /orgs/{org_id}/preferences/navigation orgs updateOrgNavigationPreferences
+//
+// Update the navigation customization of an org.
+//
+// Hidden sections must be ids of top level navigation sections and pinned items must be ids of installed app plugins.
+// Set a list to null to use the instance settings from the [navigation] section.
+//
+// Responses:
+// 200: okResponse
+// 400: badRequestError
+// 401: unauthorisedError
+// 403: forbiddenError
+// 500: internalServerError
+func (hs *HTTPServer) UpdateOrgNavigationPreferences(c *contextmodel.ReqContext) response.Response {
+	orgID, err := strconv.ParseInt(web.Params(c.Req)[":orgId"], 10, 64)
+	if err != nil {
+		return response.Error(http.StatusBadRequest, "orgId is invalid", err)
+	}
+
+	navigation := pref.NavigationPreference{}
+	if err := web.Bind(c.Req, &navigation); err != nil {
+		return response.Error(http.StatusBadRequest, "bad request data", err)
+	}
+
+	for _, id := range navigation.HiddenSections {
+		if !navtree.IsSectionID(id) {
+			return response.Error(http.StatusBadRequest, fmt.Sprintf("Unknown navigation section %q", id), nil)
+		}
+	}
+	for _, id := range navigation.PinnedItems {
+		if p, exists := hs.pluginStore.Plugin(c.Req.Context(), id); !exists || p.Type != plugins.TypeApp {
+			return response.Error(http.StatusBadRequest, fmt.Sprintf("Unknown app plugin %q", id), nil)
+		}
+	}
+
+	cmd := pref.PatchPreferenceCommand{OrgID: orgID, Navigation: &navigation}
+	if err := hs.preferenceService.Patch(c.Req.Context(), &cmd); err != nil {
+		return response.ErrOrFallback(http.StatusInternalServerError, "Failed to save navigation preferences", err)
+	}
+
+	return response.Success("Navigation preferences updated")
 }
 
 // swagger:parameters  updateUserPreferences
@@ -247,3 +336,26 @@ type PatchOrgPreferencesParams struct {
 	// required:true
 	Body dtos.PatchPrefsCmd `json:"body"`
 }
+
+// swagger:parameters getOrgNavigationPreferences
+type GetOrgNavigationPreferencesParams struct {
+	// in:path
+	// required:true
+	OrgID int64 `json:"org_id"`
+}
+
+// swagger:parameters updateOrgNavigationPreferences
+type UpdateOrgNavigationPreferencesParams struct {
+	// in:path
+	// required:true
+	OrgID int64 `json:"org_id"`
+	// in:body
+	// required:true
+	Body pref.NavigationPreference `json:"body"`
+}
+
+// swagger:response getNavigationPreferencesResponse
+type GetNavigationPreferencesResponse struct {
+	// in:body
+	Body pref.NavigationPreference `json:"body"`
+}
@@ -9,9 +9,13 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/grafana/grafana/pkg/plugins"
 	"github.com/grafana/grafana/pkg/services/accesscontrol"
 	"github.com/grafana/grafana/pkg/services/dashboards"
+	"github.com/grafana/grafana/pkg/services/datasources"
+	fakeDatasources "github.com/grafana/grafana/pkg/services/datasources/fakes"
 	"github.com/grafana/grafana/pkg/services/org"
+	"github.com/grafana/grafana/pkg/services/pluginsintegration/pluginstore"
 	pref "github.com/grafana/grafana/pkg/services/preference"
 	"github.com/grafana/grafana/pkg/services/preference/preftest"
 	"github.com/grafana/grafana/pkg/services/user"
@@ -23,6 +27,7 @@ var (
 	getOrgPreferencesURL    = "/api/org/preferences/"
 	putOrgPreferencesURL    = "/api/org/preferences/"
 	patchOrgPreferencesUrl  = "/api/org/preferences/"
+	putUserPreferencesURL   = "/api/user/preferences/"
 	patchUserPreferencesUrl = "/api/user/preferences/"
 
 	testUpdateOrgPreferencesCmd                     = `{ "theme": "light", "homeDashboardId": 1 }`
@@ -202,3 +207,122 @@ func TestAPIEndpoint_PatchOrgPreferences(t *testing.T) {
 		require.NoError(t, response.Body.Close())
 	})
 }
+
+func TestAPIEndpoint_UpdatePreferences_DefaultDatasource(t *testing.T) {
+	server := SetupAPITestServer(t, func(hs *HTTPServer) {
+		hs.Cfg = setting.NewCfg()
+		hs.preferenceService = preftest.NewPreferenceServiceFake()
+		hs.DataSourceCache = &fakeDatasources.FakeCacheService{
+			DataSources: []*datasources.DataSource{{ID: 1, UID: "prometheus", OrgID: 1}},
+		}
+	})
+
+	signedInUser := &user.SignedInUser{
+		UserID:      1,
+		OrgID:       1,
+		OrgRole:     org.RoleAdmin,
+		Permissions: map[int64]map[string][]string{1: {accesscontrol.ActionOrgsPreferencesWrite: {}}},
+	}
+
+	t.Run("Returns 200 when setting an existing data source as user default", func(t *testing.T) {
+		input := strings.NewReader(`{"defaultDatasourceUID": "prometheus"}`)
+		req := webtest.RequestWithSignedInUser(server.NewRequest(http.MethodPut, putUserPreferencesURL, input), signedInUser)
+		response, err := server.SendJSON(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, response.StatusCode)
+		require.NoError(t, response.Body.Close())
+	})
+
+	t.Run("Returns 400 when setting an unknown data source as user default", func(t *testing.T) {
+		input := strings.NewReader(`{"defaultDatasourceUID": "removed"}`)
+		req := webtest.RequestWithSignedInUser(server.NewRequest(http.MethodPut, putUserPreferencesURL, input), signedInUser)
+		response, err := server.SendJSON(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+		require.NoError(t, response.Body.Close())
+	})
+
+	t.Run("Returns 400 when patching an unknown data source as user default", func(t *testing.T) {
+		input := strings.NewReader(`{"defaultDatasourceUID": "removed"}`)
+		req := webtest.RequestWithSignedInUser(server.NewRequest(http.MethodPatch, patchUserPreferencesUrl, input), signedInUser)
+		response, err := server.SendJSON(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+		require.NoError(t, response.Body.Close())
+	})
+
+	t.Run("Returns 400 when setting a default data source for the org", func(t *testing.T) {
+		input := strings.NewReader(`{"defaultDatasourceUID": "prometheus"}`)
+		req := webtest.RequestWithSignedInUser(server.NewRequest(http.MethodPut, putOrgPreferencesURL, input), signedInUser)
+		response, err := server.SendJSON(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, response.StatusCode)
+		require.NoError(t, response.Body.Close())
+	})
+}
+
+func TestAPIEndpoint_UpdateOrgNavigationPreferences(t *testing.T) {
+	server := SetupAPITestServer(t, func(hs *HTTPServer) {
+		hs.Cfg = setting.NewCfg()
+		hs.preferenceService = preftest.NewPreferenceServiceFake()
+		hs.pluginStore = &pluginstore.FakePluginStore{
+			PluginList: []pluginstore.Plugin{
+				{JSONData: plugins.JSONData{ID: "grafana-k8s-app", Type: plugins.TypeApp}},
+				{JSONData: plugins.JSONData{ID: "grafana-clock-panel", Type: plugins.TypePanel}},
+			},
+		}
+	})
+	url := "/api/orgs/1/preferences/navigation"
+	signedInUser := userWithPermissions(1, []accesscontrol.Permission{{Action: accesscontrol.ActionOrgsPreferencesWrite}})
+
+	tests := []struct {
+		desc         string
+		body         string
+		expectedCode int
+	}{
+		{
+			desc:         "known sections and app plugins are saved",
+			body:         `{"hiddenSections": ["alerting", "cfg"], "pinnedItems": ["grafana-k8s-app"]}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			desc:         "lists can be reset to the instance settings",
+			body:         `{"hiddenSections": null, "pinnedItems": null}`,
+			expectedCode: http.StatusOK,
+		},
+		{
+			desc:         "unknown sections are rejected",
+			body:         `{"hiddenSections": ["alertng"]}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			desc:         "pinned items must be app plugins",
+			body:         `{"pinnedItems": ["grafana-clock-panel"]}`,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			desc:         "pinned items must be installed",
+			body:         `{"pinnedItems": ["missing-app"]}`,
+			expectedCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			req := webtest.RequestWithSignedInUser(server.NewRequest(http.MethodPut, url, strings.NewReader(tt.body)), signedInUser)
+			response, err := server.SendJSON(req)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedCode, response.StatusCode)
+			require.NoError(t, response.Body.Close())
+		})
+	}
+
+	t.Run("AccessControl prevents updating navigation preferences with incorrect permissions", func(t *testing.T) {
+		user := userWithPermissions(1, []accesscontrol.Permission{{Action: accesscontrol.ActionOrgsPreferencesRead}})
+		req := webtest.RequestWithSignedInUser(server.NewRequest(http.MethodPut, url, strings.NewReader(`{}`)), user)
+		response, err := server.SendJSON(req)
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusForbidden, response.StatusCode)
+		require.NoError(t, response.Body.Close())
+	})
+}
@@ -15,6 +15,9 @@ import (
 	"github.com/grafana/grafana/pkg/web"
 )
 
+// defaultRenderTimeoutSeconds is the render timeout used when the request doesn't set one.
+const defaultRenderTimeoutSeconds = 60
+
 func (hs *HTTPServer) RenderToPng(c *contextmodel.ReqContext) {
 	queryReader, err := util.NewURLQueryReader(c.Req.URL)
 	if err != nil {
@@ -36,7 +39,7 @@ func (hs *HTTPServer) RenderToPng(c *contextmodel.ReqContext) {
 		return
 	}
 
-	timeout, err := strconv.Atoi(queryReader.Get("timeout", "60"))
+	timeout, err := strconv.Atoi(queryReader.Get("timeout", strconv.Itoa(defaultRenderTimeoutSeconds)))
 	if err != nil {
 		c.Handle(hs.Cfg, 400, "Render parameters error", fmt.Errorf("cannot parse timeout as int: %s", err))
 		return
@@ -34,6 +34,10 @@ func (hs *HTTPServer) Search(c *contextmodel.ReqContext) response.Response {
 		return response.Error(422, "Limit is above maximum allowed (5000), use page parameter to access hits beyond limit", nil)
 	}
 
+	if maxResults := int64(hs.Cfg.Search.MaxResults); maxResults > 0 && (limit == 0 || limit > maxResults) {
+		limit = maxResults
+	}
+
 	if c.Query("permission") == "Edit" {
 		permission = dashboards.PERMISSION_EDIT
 	}
@@ -0,0 +1,80 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/grafana/grafana/pkg/services/org"
+	"github.com/grafana/grafana/pkg/services/search"
+	"github.com/grafana/grafana/pkg/services/search/model"
+	"github.com/grafana/grafana/pkg/services/user"
+	"github.com/grafana/grafana/pkg/setting"
+	"github.com/grafana/grafana/pkg/web/webtest"
+)
+
+type recordingSearchService struct {
+	mockSearchService
+	query *search.Query
+}
+
+func (rss *recordingSearchService) SearchHandler(_ context.Context, q *search.Query) (model.HitList, error) {
+	rss.query = q
+	return rss.ExpectedResult, nil
+}
+
+func TestSearchAPIEndpoint_MaxResults(t *testing.T) {
+	tests := []struct {
+		desc          string
+		maxResults    int
+		url           string
+		expectedLimit int64
+	}{
+		{
+			desc:          "no max results keeps the requested limit",
+			url:           "/api/search?limit=2000",
+			expectedLimit: 2000,
+		},
+		{
+			desc:          "requested limit above max results is capped",
+			maxResults:    100,
+			url:           "/api/search?limit=2000",
+			expectedLimit: 100,
+		},
+		{
+			desc:          "request without a limit is capped",
+			maxResults:    100,
+			url:           "/api/search",
+			expectedLimit: 100,
+		},
+		{
+			desc:          "requested limit below max results is kept",
+			maxResults:    100,
+			url:           "/api/search?limit=10",
+			expectedLimit: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			searchService := &recordingSearchService{}
+			server := SetupAPITestServer(t, func(hs *HTTPServer) {
+				hs.Cfg = setting.NewCfg()
+				hs.Cfg.Search.MaxResults = tt.maxResults
+				hs.SearchService = searchService
+			})
+
+			req := webtest.RequestWithSignedInUser(server.NewGetRequest(tt.url), &user.SignedInUser{UserID: 1, OrgID: 1, OrgRole: org.RoleViewer})
+			res, err := server.Send(req)
+			require.NoError(t, err)
+			assert.Equal(t, http.StatusOK, res.StatusCode)
+			require.NoError(t, res.Body.Close())
+
+			require.NotNil(t, searchService.query)
+			assert.Equal(t, tt.expectedLimit, searchService.query.Limit)
+		})
+	}
+}
@@ -70,6 +70,13 @@ const (
 	MappingTypeValue   MappingType = "value"
 )
 
+// Defines values for PanelBorderStyle.
+const (
+	PanelBorderStyleNone   PanelBorderStyle = "none"
+	PanelBorderStyleShadow PanelBorderStyle = "shadow"
+	PanelBorderStyleSolid  PanelBorderStyle = "solid"
+)
+
 // Defines values for PanelRepeatDirection.
 const (
 	PanelRepeatDirectionH PanelRepeatDirection = "h"
@@ -523,6 +530,9 @@ type MatcherConfig struct {
 
 // Dashboard panels are the basic visualization building blocks.
 type Panel struct {
+	// Border of the panel. `none` hides the border, `shadow` replaces it with a drop shadow.
+	BorderStyle *PanelBorderStyle `json:"borderStyle,omitempty"`
+
 	// Ref to a DataSource instance
 	Datasource *DataSourceRef `json:"datasource,omitempty"`
 
@@ -614,6 +624,9 @@ type Panel struct {
 	Type string `json:"type"`
 }
 
+// Border of the panel. `none` hides the border, `shadow` replaces it with a drop shadow.
+type PanelBorderStyle string
+
 // Direction to repeat in if 'repeat' is set.
 // `h` for horizontal, `v` for vertical.
 type PanelRepeatDirection string
@@ -26,6 +26,9 @@ type QueryHistoryPreference struct {
 type Spec struct {
 	CookiePreferences *CookiePreferences `json:"cookiePreferences,omitempty"`
 
+	// UID for the default data source
+	DefaultDatasourceUID *string `json:"defaultDatasourceUID,omitempty"`
+
 	// UID for the home dashboard
 	HomeDashboardUID *string `json:"homeDashboardUID,omitempty"`
 
@@ -65,6 +65,8 @@ type Identity struct {
 	IsDisabled bool
 	// HelpFlags1 is the help flags for the entity.
 	HelpFlags1 user.HelpFlags1
+	// MustChangePassword is true if an admin wants the entity to change its password.
+	MustChangePassword bool
 	// LastSeenAt is the time when the entity was last seen.
 	LastSeenAt time.Time
 	// Teams is the list of teams the entity is a member of.
@@ -222,6 +224,8 @@ func (i *Identity) SignedInUser() *user.SignedInUser {
 		Teams:           i.Teams,
 		Permissions:     i.Permissions,
 		IDToken:         i.IDToken,
+
+		MustChangePassword: i.MustChangePassword,
 	}
 
 	if namespace == NamespaceAPIKey {
@@ -280,5 +284,7 @@ func IdentityFromSignedInUser(id string, usr *user.SignedInUser, params ClientPa
 		ClientParams:    params,
 		Permissions:     usr.Permissions,
 		IDToken:         usr.IDToken,
+
+		MustChangePassword: usr.MustChangePassword,
 	}
 }
@@ -91,6 +91,14 @@ var (
 		Reason:     "Dashboard refresh interval is too low",
 		StatusCode: 400,
 	}
+	ErrDashboardTooManyPanels = DashboardErr{
+		Reason:     "Dashboard has more panels than allowed",
+		StatusCode: 400,
+	}
+	ErrDashboardTooManyTemplateVariables = DashboardErr{
+		Reason:     "Dashboard has more template variables than allowed",
+		StatusCode: 400,
+	}
 	ErrDashboardCannotDeleteProvisionedDashboard = DashboardErr{
 		Reason:     "provisioned dashboard cannot be deleted",
 		StatusCode: 400,
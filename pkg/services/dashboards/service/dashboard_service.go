@@ -124,6 +124,10 @@ func (dr *DashboardServiceImpl) BuildSaveDashboardCommand(ctx context.Context, d
 		return nil, err
 	}
 
+	if err := dr.validateDashboardLimits(dash); err != nil {
+		return nil, err
+	}
+
 	if shouldValidateAlerts {
 		dashAlertInfo := alerting.DashAlertInfo{Dash: dash, User: dto.User, OrgID: dash.OrgID}
 		if err := dr.dashAlertExtractor.ValidateAlerts(ctx, dashAlertInfo); err != nil {
@@ -276,6 +280,39 @@ func validateDashboardRefreshInterval(dash *dashboards.Dashboard) error {
 	return nil
 }
 
+// validateDashboardLimits checks the dashboard against the configured maximum number of panels
+// and template variables. Panels inside collapsed rows count towards the limit, rows themselves don't.
+func (dr *DashboardServiceImpl) validateDashboardLimits(dash *dashboards.Dashboard) error {
+	if dash.IsFolder {
+		return nil
+	}
+
+	if !setting.WithinLimit(countDashboardPanels(dash), dr.cfg.DashboardMaxPanels) {
+		return dashboards.ErrDashboardTooManyPanels
+	}
+
+	if !setting.WithinLimit(len(dash.Data.GetPath("templating", "list").MustArray()), dr.cfg.DashboardMaxTemplateVariables) {
+		return dashboards.ErrDashboardTooManyTemplateVariables
+	}
+
+	return nil
+}
+
+func countDashboardPanels(dash *dashboards.Dashboard) int {
+	count := 0
+	for _, p := range dash.Data.Get("panels").MustArray() {
+		panel, ok := p.(map[string]any)
+		if !ok || panel["type"] != "row" {
+			count++
+			continue
+		}
+		if rowPanels, ok := panel["panels"].([]any); ok {
+			count += len(rowPanels)
+		}
+	}
+	return count
+}
+
 func (dr *DashboardServiceImpl) SaveProvisionedDashboard(ctx context.Context, dto *dashboards.SaveDashboardDTO,
 	provisioning *dashboards.DashboardProvisioning) (*dashboards.Dashboard, error) {
 	if err := validateDashboardRefreshInterval(dto.Dashboard); err != nil {
@@ -92,6 +92,38 @@ func TestDashboardService(t *testing.T) {
 				}
 			})
 
+			t.Run("Should return validation error if dashboard has more panels than allowed", func(t *testing.T) {
+				service.cfg.DashboardMaxPanels = 1
+				t.Cleanup(func() { service.cfg.DashboardMaxPanels = 0 })
+
+				dto.Dashboard = dashboards.NewDashboardFromJson(simplejson.NewFromAny(map[string]any{
+					"title": "Dash",
+					"panels": []any{
+						map[string]any{"type": "timeseries"},
+						map[string]any{"type": "row", "collapsed": true, "panels": []any{
+							map[string]any{"type": "stat"},
+						}},
+					},
+				}))
+				_, err := service.BuildSaveDashboardCommand(context.Background(), dto, false, false)
+				require.Equal(t, err, dashboards.ErrDashboardTooManyPanels)
+			})
+
+			t.Run("Should return validation error if dashboard has more template variables than allowed", func(t *testing.T) {
+				service.cfg.DashboardMaxTemplateVariables = 1
+				t.Cleanup(func() { service.cfg.DashboardMaxTemplateVariables = 0 })
+
+				dto.Dashboard = dashboards.NewDashboardFromJson(simplejson.NewFromAny(map[string]any{
+					"title": "Dash",
+					"templating": map[string]any{"list": []any{
+						map[string]any{"name": "env"},
+						map[string]any{"name": "cluster"},
+					}},
+				}))
+				_, err := service.BuildSaveDashboardCommand(context.Background(), dto, false, false)
+				require.Equal(t, err, dashboards.ErrDashboardTooManyTemplateVariables)
+			})
+
 			t.Run("Should return validation error if dashboard is provisioned", func(t *testing.T) {
 				fakeStore.On("ValidateDashboardBeforeSave", mock.Anything, mock.Anything, mock.AnythingOfType("bool")).Return(true, nil).Once()
 				fakeStore.On("GetProvisionedDataByDashboardID", mock.Anything, mock.AnythingOfType("int64")).Return(&dashboards.DashboardProvisioning{}, nil).Once()
@@ -286,3 +318,20 @@ func TestDashboardService(t *testing.T) {
 		})
 	})
 }
+
+func TestCountDashboardPanels(t *testing.T) {
+	dash := dashboards.NewDashboardFromJson(simplejson.NewFromAny(map[string]any{
+		"title": "Dash",
+		"panels": []any{
+			map[string]any{"type": "timeseries"},
+			map[string]any{"type": "row", "collapsed": false},
+			map[string]any{"type": "stat"},
+			map[string]any{"type": "row", "collapsed": true, "panels": []any{
+				map[string]any{"type": "table"},
+				map[string]any{"type": "text"},
+			}},
+		},
+	}))
+
+	require.Equal(t, 4, countDashboardPanels(dash))
+}
@@ -58,3 +58,10 @@ func newConfig(cfg *setting.Cfg) *config {
 		apiURL:      apiURL,
 	}
 }
+
+// IsUnifiedStorageEnabled reports whether the API server is enabled and stores resources
+// in unified storage instead of the legacy SQL tables.
+func IsUnifiedStorageEnabled(cfg *setting.Cfg) bool {
+	c := newConfig(cfg)
+	return c.enabled && c.storageType != StorageTypeLegacy
+}
@@ -38,3 +38,15 @@ func TestNewConfig(t *testing.T) {
 	}
 	require.Equal(t, expected, actual)
 }
+
+func TestIsUnifiedStorageEnabled(t *testing.T) {
+	cfg := setting.NewCfg()
+	cfg.IsFeatureToggleEnabled = func(_ string) bool { return false }
+	require.False(t, IsUnifiedStorageEnabled(cfg))
+
+	cfg.IsFeatureToggleEnabled = func(_ string) bool { return true }
+	require.False(t, IsUnifiedStorageEnabled(cfg))
+
+	cfg.Raw.Section("grafana-apiserver").Key("storage_type").SetValue(string(StorageTypeFile))
+	require.True(t, IsUnifiedStorageEnabled(cfg))
+}
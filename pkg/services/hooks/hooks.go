@@ -1,18 +1,42 @@
 package hooks
 
 import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sync"
+
 	"github.com/grafana/grafana/pkg/api/dtos"
+	"github.com/grafana/grafana/pkg/infra/log"
 	contextmodel "github.com/grafana/grafana/pkg/services/contexthandler/model"
 )
 
+var (
+	ErrInvalidExtensionNamespace = errors.New("invalid frontend settings extension namespace")
+	ErrExtensionNamespaceTaken   = errors.New("frontend settings extension namespace is already registered")
+
+	extensionNamespacePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._-]{0,63}$`)
+)
+
 type IndexDataHook func(indexData *dtos.IndexViewData, req *contextmodel.ReqContext)
 
+// FrontendSettingsExtension returns the settings blob a plugin ships to its frontend.
+type FrontendSettingsExtension func(req *contextmodel.ReqContext) (json.RawMessage, error)
+
 type HooksService struct {
+	log            log.Logger
 	indexDataHooks []IndexDataHook
+
+	extensionsMu               sync.RWMutex
+	frontendSettingsExtensions map[string]FrontendSettingsExtension
 }
 
 func ProvideService() *HooksService {
-	return &HooksService{}
+	return &HooksService{
+		log:                        log.New("hooks"),
+		frontendSettingsExtensions: map[string]FrontendSettingsExtension{},
+	}
 }
 
 func (srv *HooksService) AddIndexDataHook(hook IndexDataHook) {
@@ -24,3 +48,50 @@ func (srv *HooksService) RunIndexDataHooks(indexData *dtos.IndexViewData, req *c
 		hook(indexData, req)
 	}
 }
+
+// AddFrontendSettingsExtension registers an extension whose settings are added to the
+// frontend settings under the given namespace. Namespaces are lowercase, start with a
+// letter or digit and can't be registered twice.
+func (srv *HooksService) AddFrontendSettingsExtension(namespace string, extension FrontendSettingsExtension) error {
+	if !extensionNamespacePattern.MatchString(namespace) {
+		return fmt.Errorf("%w: %q", ErrInvalidExtensionNamespace, namespace)
+	}
+
+	srv.extensionsMu.Lock()
+	defer srv.extensionsMu.Unlock()
+
+	if _, exists := srv.frontendSettingsExtensions[namespace]; exists {
+		return fmt.Errorf("%w: %q", ErrExtensionNamespaceTaken, namespace)
+	}
+	srv.frontendSettingsExtensions[namespace] = extension
+	return nil
+}
+
+// RunFrontendSettingsExtensions returns the settings of all registered extensions keyed
+// by namespace. Extensions that fail or return invalid JSON are left out.
+func (srv *HooksService) RunFrontendSettingsExtensions(req *contextmodel.ReqContext) map[string]json.RawMessage {
+	srv.extensionsMu.RLock()
+	extensions := make(map[string]FrontendSettingsExtension, len(srv.frontendSettingsExtensions))
+	for namespace, extension := range srv.frontendSettingsExtensions {
+		extensions[namespace] = extension
+	}
+	srv.extensionsMu.RUnlock()
+
+	settings := make(map[string]json.RawMessage, len(extensions))
+	for namespace, extension := range extensions {
+		blob, err := extension(req)
+		if err != nil {
+			srv.log.Warn("Failed to get frontend settings extension", "namespace", namespace, "error", err)
+			continue
+		}
+		if len(blob) == 0 {
+			continue
+		}
+		if !json.Valid(blob) {
+			srv.log.Warn("Frontend settings extension returned invalid JSON", "namespace", namespace)
+			continue
+		}
+		settings[namespace] = blob
+	}
+	return settings
+}
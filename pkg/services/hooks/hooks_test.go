@@ -0,0 +1,63 @@
+package hooks
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	contextmodel "github.com/grafana/grafana/pkg/services/contexthandler/model"
+)
+
+func TestHooksService_FrontendSettingsExtensions(t *testing.T) {
+	static := func(blob string) FrontendSettingsExtension {
+		return func(*contextmodel.ReqContext) (json.RawMessage, error) {
+			return json.RawMessage(blob), nil
+		}
+	}
+
+	t.Run("settings of registered extensions are returned by namespace", func(t *testing.T) {
+		srv := ProvideService()
+		require.NoError(t, srv.AddFrontendSettingsExtension("grafana-reporting-app", static(`{"enabled":true}`)))
+		require.NoError(t, srv.AddFrontendSettingsExtension("acme.branding", static(`{"theme":"dark"}`)))
+
+		settings := srv.RunFrontendSettingsExtensions(&contextmodel.ReqContext{})
+		assert.Equal(t, map[string]json.RawMessage{
+			"grafana-reporting-app": json.RawMessage(`{"enabled":true}`),
+			"acme.branding":         json.RawMessage(`{"theme":"dark"}`),
+		}, settings)
+	})
+
+	t.Run("registering a namespace twice fails", func(t *testing.T) {
+		srv := ProvideService()
+		require.NoError(t, srv.AddFrontendSettingsExtension("grafana-reporting-app", static(`{"enabled":true}`)))
+
+		err := srv.AddFrontendSettingsExtension("grafana-reporting-app", static(`{"enabled":false}`))
+		require.ErrorIs(t, err, ErrExtensionNamespaceTaken)
+
+		settings := srv.RunFrontendSettingsExtensions(&contextmodel.ReqContext{})
+		assert.Equal(t, json.RawMessage(`{"enabled":true}`), settings["grafana-reporting-app"])
+	})
+
+	t.Run("invalid namespaces are rejected", func(t *testing.T) {
+		srv := ProvideService()
+		for _, namespace := range []string{"", "Reporting", "-reporting", "reporting app", "a/b"} {
+			err := srv.AddFrontendSettingsExtension(namespace, static(`{}`))
+			assert.ErrorIs(t, err, ErrInvalidExtensionNamespace, namespace)
+		}
+	})
+
+	t.Run("failing extensions and invalid JSON are left out", func(t *testing.T) {
+		srv := ProvideService()
+		require.NoError(t, srv.AddFrontendSettingsExtension("failing", func(*contextmodel.ReqContext) (json.RawMessage, error) {
+			return nil, errors.New("boom")
+		}))
+		require.NoError(t, srv.AddFrontendSettingsExtension("invalid", static(`{"enabled":`)))
+		require.NoError(t, srv.AddFrontendSettingsExtension("valid", static(`[1,2]`)))
+
+		settings := srv.RunFrontendSettingsExtensions(&contextmodel.ReqContext{})
+		assert.Equal(t, map[string]json.RawMessage{"valid": json.RawMessage(`[1,2]`)}, settings)
+	})
+}
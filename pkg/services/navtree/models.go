@@ -30,7 +30,10 @@ const (
 
 const (
 	NavIDRoot               = "root"
+	NavIDHome               = "home"
+	NavIDStarred            = "starred"
 	NavIDDashboards         = "dashboards/browse"
+	NavIDExplore            = "explore"
 	NavIDCfg                = "cfg" // NavIDCfg is the id for org configuration navigation node
 	NavIDAlertsAndIncidents = "alerts-and-incidents"
 	NavIDAlerting           = "alerting"
@@ -38,11 +41,36 @@ const (
 	NavIDMonitoring         = "monitoring"
 	NavIDReporting          = "reports"
 	NavIDApps               = "apps"
+	NavIDConnections        = "connections"
+	NavIDProfile            = "profile"
+	NavIDHelp               = "help"
 	NavIDCfgGeneral         = "cfg/general"
 	NavIDCfgPlugins         = "cfg/plugins"
 	NavIDCfgAccess          = "cfg/access"
 )
 
+// sectionIDs are the ids of the top level sections of the navigation tree.
+var sectionIDs = map[string]bool{
+	NavIDHome:               true,
+	NavIDStarred:            true,
+	NavIDDashboards:         true,
+	NavIDExplore:            true,
+	NavIDAlerting:           true,
+	NavIDAlertingLegacy:     true,
+	NavIDAlertsAndIncidents: true,
+	NavIDMonitoring:         true,
+	NavIDApps:               true,
+	NavIDConnections:        true,
+	NavIDCfg:                true,
+	NavIDProfile:            true,
+	NavIDHelp:               true,
+}
+
+// IsSectionID reports whether id is the id of a top level section of the navigation tree.
+func IsSectionID(id string) bool {
+	return sectionIDs[id]
+}
+
 type NavLink struct {
 	Id             string     `json:"id,omitempty"`
 	Text           string     `json:"text"`
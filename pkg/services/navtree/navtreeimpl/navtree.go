@@ -86,7 +86,7 @@ func (s *ServiceImpl) GetNavTree(c *contextmodel.ReqContext, prefs *pref.Prefere
 
 		treeRoot.AddSection(&navtree.NavLink{
 			Text:           "Starred",
-			Id:             "starred",
+			Id:             navtree.NavIDStarred,
 			Icon:           "star",
 			SortWeight:     navtree.WeightSavedItems,
 			Children:       starredItemsLinks,
@@ -117,7 +117,7 @@ func (s *ServiceImpl) GetNavTree(c *contextmodel.ReqContext, prefs *pref.Prefere
 	if setting.ExploreEnabled && hasAccess(ac.EvalPermission(ac.ActionDatasourcesExplore)) {
 		treeRoot.AddSection(&navtree.NavLink{
 			Text:       "Explore",
-			Id:         "explore",
+			Id:         navtree.NavIDExplore,
 			SubTitle:   "Explore your data",
 			Icon:       "compass",
 			SortWeight: navtree.WeightExplore,
@@ -177,7 +177,7 @@ func (s *ServiceImpl) getHomeNode(c *contextmodel.ReqContext, prefs *pref.Prefer
 
 	homeNode := &navtree.NavLink{
 		Text:       "Home",
-		Id:         "home",
+		Id:         navtree.NavIDHome,
 		Url:        homeUrl,
 		Icon:       "home-alt",
 		SortWeight: navtree.WeightHome,
@@ -199,7 +199,7 @@ func (s *ServiceImpl) addHelpLinks(treeRoot *navtree.NavTreeRoot, c *contextmode
 		helpNode := &navtree.NavLink{
 			Text:       "Help",
 			SubTitle:   helpVersion,
-			Id:         "help",
+			Id:         navtree.NavIDHelp,
 			Url:        "#",
 			Icon:       "question-circle",
 			SortWeight: navtree.WeightHelp,
@@ -268,7 +268,7 @@ func (s *ServiceImpl) getProfileNode(c *contextmodel.ReqContext) *navtree.NavLin
 	return &navtree.NavLink{
 		Text:       c.SignedInUser.NameOrFallback(),
 		SubTitle:   login,
-		Id:         "profile",
+		Id:         navtree.NavIDProfile,
 		Img:        gravatarURL,
 		Url:        s.cfg.AppSubURL + "/profile",
 		SortWeight: navtree.WeightProfile,
@@ -492,7 +492,7 @@ func (s *ServiceImpl) buildDataConnectionsNavLink(c *contextmodel.ReqContext) *n
 		navLink = &navtree.NavLink{
 			Text:       "Connections",
 			Icon:       "adjust-circle",
-			Id:         "connections",
+			Id:         navtree.NavIDConnections,
 			Url:        baseUrl,
 			Children:   children,
 			SortWeight: navtree.WeightDataConnections,
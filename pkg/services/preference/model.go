@@ -67,6 +67,8 @@ type SavePreferenceCommand struct {
 	Language          string                  `json:"language,omitempty"`
 	QueryHistory      *QueryHistoryPreference `json:"queryHistory,omitempty"`
 	CookiePreferences []CookieType            `json:"cookiePreferences,omitempty"`
+	// UID of the data source used as default, only honored for user and team preferences
+	DefaultDatasourceUID string `json:"defaultDatasourceUID,omitempty"`
 }
 
 type PatchPreferenceCommand struct {
@@ -82,18 +84,31 @@ type PatchPreferenceCommand struct {
 	Language          *string                 `json:"language,omitempty"`
 	QueryHistory      *QueryHistoryPreference `json:"queryHistory,omitempty"`
 	CookiePreferences []CookieType            `json:"cookiePreferences,omitempty"`
+	// UID of the data source used as default, only honored for user and team preferences
+	DefaultDatasourceUID *string `json:"defaultDatasourceUID,omitempty"`
+	// Navigation customization, only honored for org preferences
+	Navigation *NavigationPreference `json:"navigation,omitempty"`
 }
 
 type PreferenceJSONData struct {
-	Language          string                 `json:"language"`
-	QueryHistory      QueryHistoryPreference `json:"queryHistory"`
-	CookiePreferences map[string]struct{}    `json:"cookiePreferences"`
+	Language             string                 `json:"language"`
+	QueryHistory         QueryHistoryPreference `json:"queryHistory"`
+	CookiePreferences    map[string]struct{}    `json:"cookiePreferences"`
+	DefaultDatasourceUID string                 `json:"defaultDatasourceUID,omitempty"`
+	Navigation           *NavigationPreference  `json:"navigation,omitempty"`
 }
 
 type QueryHistoryPreference struct {
 	HomeTab string `json:"homeTab"`
 }
 
+// NavigationPreference overrides the instance navigation settings for an org.
+// A nil list keeps the instance setting, an empty list clears it.
+type NavigationPreference struct {
+	HiddenSections []string `json:"hiddenSections"`
+	PinnedItems    []string `json:"pinnedItems"`
+}
+
 func (j *PreferenceJSONData) FromDB(data []byte) error {
 	dec := json.NewDecoder(bytes.NewBuffer(data))
 	dec.UseNumber()
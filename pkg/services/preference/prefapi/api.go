@@ -3,22 +3,34 @@ package prefapi
 
 import (
 	"context"
+	"errors"
 	"net/http"
 
 	"github.com/grafana/grafana/pkg/api/dtos"
 	"github.com/grafana/grafana/pkg/api/response"
 	"github.com/grafana/grafana/pkg/kinds/preferences"
+	"github.com/grafana/grafana/pkg/services/auth/identity"
 	"github.com/grafana/grafana/pkg/services/dashboards"
+	"github.com/grafana/grafana/pkg/services/datasources"
 	pref "github.com/grafana/grafana/pkg/services/preference"
 )
 
 func UpdatePreferencesFor(ctx context.Context,
-	dashboardService dashboards.DashboardService, preferenceService pref.Service,
-	orgID, userID, teamId int64, dtoCmd *dtos.UpdatePrefsCmd) response.Response {
+	dashboardService dashboards.DashboardService, dataSourceCache datasources.CacheService, preferenceService pref.Service,
+	user identity.Requester, orgID, userID, teamId int64, dtoCmd *dtos.UpdatePrefsCmd) response.Response {
 	if dtoCmd.Theme != "" && !pref.IsValidThemeID(dtoCmd.Theme) {
 		return response.Error(http.StatusBadRequest, "Invalid theme", nil)
 	}
 
+	if dtoCmd.DefaultDatasourceUID != "" {
+		if userID == 0 && teamId == 0 {
+			return response.Error(http.StatusBadRequest, "Default data source can only be set for users and teams", nil)
+		}
+		if resp := ValidateDefaultDatasource(ctx, dataSourceCache, user, dtoCmd.DefaultDatasourceUID); resp != nil {
+			return resp
+		}
+	}
+
 	dashboardID := dtoCmd.HomeDashboardID
 	if dtoCmd.HomeDashboardUID != nil {
 		query := dashboards.GetDashboardQuery{UID: *dtoCmd.HomeDashboardUID, OrgID: orgID}
@@ -46,6 +58,8 @@ func UpdatePreferencesFor(ctx context.Context,
 		HomeDashboardID:   dtoCmd.HomeDashboardID,
 		QueryHistory:      dtoCmd.QueryHistory,
 		CookiePreferences: dtoCmd.Cookies,
+
+		DefaultDatasourceUID: dtoCmd.DefaultDatasourceUID,
 	}
 
 	if err := preferenceService.Save(ctx, &saveCmd); err != nil {
@@ -55,6 +69,23 @@ func UpdatePreferencesFor(ctx context.Context,
 	return response.Success("Preferences updated")
 }
 
+// ValidateDefaultDatasource checks that the data source exists in the requester's
+// organization and that the requester is allowed to query it. It returns nil when
+// the data source can be used as a default.
+func ValidateDefaultDatasource(ctx context.Context, dataSourceCache datasources.CacheService, user identity.Requester, uid string) response.Response {
+	_, err := dataSourceCache.GetDatasourceByUID(ctx, uid, user, false)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, datasources.ErrDataSourceNotFound):
+		return response.Error(http.StatusBadRequest, "Default data source not found", err)
+	case errors.Is(err, datasources.ErrDataSourceAccessDenied):
+		return response.Error(http.StatusForbidden, "Access denied to default data source", err)
+	default:
+		return response.Error(http.StatusInternalServerError, "Failed to validate default data source", err)
+	}
+}
+
 func GetPreferencesFor(ctx context.Context,
 	dashboardService dashboards.DashboardService, preferenceService pref.Service,
 	orgID, userID, teamID int64) response.Response {
@@ -101,6 +132,10 @@ func GetPreferencesFor(ctx context.Context,
 				HomeTab: &preference.JSONData.QueryHistory.HomeTab,
 			}
 		}
+
+		if preference.JSONData.DefaultDatasourceUID != "" {
+			dto.DefaultDatasourceUID = &preference.JSONData.DefaultDatasourceUID
+		}
 	}
 
 	return response.JSON(http.StatusOK, &dto)
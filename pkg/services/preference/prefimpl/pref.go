@@ -72,6 +72,10 @@ func (s *Service) GetWithDefaults(ctx context.Context, query *pref.GetPreference
 			if p.JSONData.CookiePreferences != nil {
 				res.JSONData.CookiePreferences = p.JSONData.CookiePreferences
 			}
+
+			if p.JSONData.DefaultDatasourceUID != "" {
+				res.JSONData.DefaultDatasourceUID = p.JSONData.DefaultDatasourceUID
+			}
 		}
 	}
 
@@ -133,6 +137,10 @@ func (s *Service) Save(ctx context.Context, cmd *pref.SavePreferenceCommand) err
 	preference.Updated = time.Now()
 	preference.Version += 1
 	preference.HomeDashboardID = cmd.HomeDashboardID
+	// navigation is managed separately and isn't part of the saved preferences
+	if preference.JSONData != nil {
+		jsonData.Navigation = preference.JSONData.Navigation
+	}
 	preference.JSONData = jsonData
 
 	return s.store.Update(ctx, preference)
@@ -193,6 +201,20 @@ func (s *Service) Patch(ctx context.Context, cmd *pref.PatchPreferenceCommand) e
 		preference.JSONData.CookiePreferences = cookies
 	}
 
+	if cmd.DefaultDatasourceUID != nil {
+		if preference.JSONData == nil {
+			preference.JSONData = &pref.PreferenceJSONData{}
+		}
+		preference.JSONData.DefaultDatasourceUID = *cmd.DefaultDatasourceUID
+	}
+
+	if cmd.Navigation != nil {
+		if preference.JSONData == nil {
+			preference.JSONData = &pref.PreferenceJSONData{}
+		}
+		preference.JSONData.Navigation = cmd.Navigation
+	}
+
 	if cmd.Timezone != nil {
 		preference.Timezone = *cmd.Timezone
 	}
@@ -253,7 +275,8 @@ func parseCookiePreferences(prefs []pref.CookieType) (map[string]struct{}, error
 
 func preferenceData(cmd *pref.SavePreferenceCommand) (*pref.PreferenceJSONData, error) {
 	jsonData := &pref.PreferenceJSONData{
-		Language: cmd.Language,
+		Language:             cmd.Language,
+		DefaultDatasourceUID: cmd.DefaultDatasourceUID,
 	}
 
 	if cmd.QueryHistory != nil {
@@ -395,6 +395,23 @@ func TestSave(t *testing.T) {
 		assert.Equal(t, "1", *stored.WeekStart)
 		assert.EqualValues(t, 2, stored.Version)
 	})
+
+	t.Run("patch navigation and keep it on update", func(t *testing.T) {
+		navigation := &pref.NavigationPreference{HiddenSections: []string{"alerting"}}
+		err := prefService.Patch(context.Background(), &pref.PatchPreferenceCommand{
+			OrgID:      1,
+			Navigation: navigation,
+		})
+		require.NoError(t, err)
+
+		err = prefService.Save(context.Background(), &pref.SavePreferenceCommand{OrgID: 1, Theme: "dark"})
+		require.NoError(t, err)
+
+		stored := prefService.store.(*inmemStore).preference[preferenceKey{OrgID: 1}]
+		assert.Equal(t, "dark", stored.Theme)
+		require.NotNil(t, stored.JSONData)
+		assert.Equal(t, navigation, stored.JSONData.Navigation)
+	})
 }
 
 func insertPrefs(t testing.TB, store store, preferences ...pref.Preference) {
@@ -54,6 +54,16 @@ func TestDashboardsAsConfig(t *testing.T) {
 			validateDashboardAsConfig(t, cfg)
 		})
 
+		t.Run("Update interval is the longest of all configs", func(t *testing.T) {
+			t.Setenv("TEST_VAR", "general")
+			cfgProvider := configReader{path: simpleDashboardConfig, log: logger, orgService: orgFake}
+			cfg, err := cfgProvider.readConfig(context.Background())
+			require.NoError(t, err)
+
+			provisioner := &Provisioner{configs: cfg}
+			require.Equal(t, int64(15), provisioner.GetUpdateIntervalSeconds())
+		})
+
 		t.Run("Can read config file in version 0 format", func(t *testing.T) {
 			cfgProvider := configReader{path: oldVersion, log: logger, orgService: orgFake}
 			cfg, err := cfgProvider.readConfig(context.Background())
@@ -19,11 +19,12 @@ type DashboardProvisioner interface {
 	PollChanges(ctx context.Context)
 	GetProvisionerResolvedPath(name string) string
 	GetAllowUIUpdatesFromConfig(name string) bool
+	GetUpdateIntervalSeconds() int64
 	CleanUpOrphanedDashboards(ctx context.Context)
 }
 
 // DashboardProvisionerFactory creates DashboardProvisioners based on input
-type DashboardProvisionerFactory func(context.Context, string, dashboards.DashboardProvisioningService, org.Service, utils.DashboardStore) (DashboardProvisioner, error)
+type DashboardProvisionerFactory func(context.Context, string, int, dashboards.DashboardProvisioningService, org.Service, utils.DashboardStore) (DashboardProvisioner, error)
 
 // Provisioner is responsible for syncing dashboard from disk to Grafana's database.
 type Provisioner struct {
@@ -38,8 +39,9 @@ func (provider *Provisioner) HasDashboardSources() bool {
 	return len(provider.fileReaders) > 0
 }
 
-// New returns a new DashboardProvisioner
-func New(ctx context.Context, configDirectory string, provisioner dashboards.DashboardProvisioningService, orgService org.Service, dashboardStore utils.DashboardStore) (DashboardProvisioner, error) {
+// New returns a new DashboardProvisioner. maxConcurrent limits how many of its file readers
+// may walk the disk at the same time, 0 means unlimited.
+func New(ctx context.Context, configDirectory string, maxConcurrent int, provisioner dashboards.DashboardProvisioningService, orgService org.Service, dashboardStore utils.DashboardStore) (DashboardProvisioner, error) {
 	logger := log.New("provisioning.dashboard")
 	cfgReader := &configReader{path: configDirectory, log: logger, orgService: orgService}
 	configs, err := cfgReader.readConfig(ctx)
@@ -52,6 +54,13 @@ func New(ctx context.Context, configDirectory string, provisioner dashboards.Das
 		return nil, fmt.Errorf("%v: %w", "Failed to initialize file readers", err)
 	}
 
+	if maxConcurrent > 0 {
+		limiter := make(chan struct{}, maxConcurrent)
+		for _, reader := range fileReaders {
+			reader.limiter = limiter
+		}
+	}
+
 	d := &Provisioner{
 		log:                logger,
 		fileReaders:        fileReaders,
@@ -126,6 +135,18 @@ func (provider *Provisioner) GetAllowUIUpdatesFromConfig(name string) bool {
 	return false
 }
 
+// GetUpdateIntervalSeconds returns the longest interval, in seconds, at which the dashboard
+// provisioners poll for changes, or 0 if there are no dashboard provisioners.
+func (provider *Provisioner) GetUpdateIntervalSeconds() int64 {
+	var interval int64
+	for _, config := range provider.configs {
+		if config.UpdateIntervalSeconds > interval {
+			interval = config.UpdateIntervalSeconds
+		}
+	}
+	return interval
+}
+
 func getFileReaders(
 	configs []*config, logger log.Logger, service dashboards.DashboardProvisioningService, store utils.DashboardStore,
 ) ([]*FileReader, error) {
@@ -8,6 +8,7 @@ type calls struct {
 	PollChanges                 []any
 	GetProvisionerResolvedPath  []any
 	GetAllowUIUpdatesFromConfig []any
+	GetUpdateIntervalSeconds    []any
 }
 
 // ProvisionerMock is a mock implementation of `Provisioner`
@@ -17,6 +18,7 @@ type ProvisionerMock struct {
 	PollChangesFunc                 func(ctx context.Context)
 	GetProvisionerResolvedPathFunc  func(name string) string
 	GetAllowUIUpdatesFromConfigFunc func(name string) bool
+	GetUpdateIntervalSecondsFunc    func() int64
 }
 
 // NewDashboardProvisionerMock returns a new dashboardprovisionermock
@@ -65,5 +67,14 @@ func (dpm *ProvisionerMock) GetAllowUIUpdatesFromConfig(name string) bool {
 	return false
 }
 
+// GetUpdateIntervalSeconds is a mock implementation of `Provisioner.GetUpdateIntervalSeconds`
+func (dpm *ProvisionerMock) GetUpdateIntervalSeconds() int64 {
+	dpm.Calls.GetUpdateIntervalSeconds = append(dpm.Calls.GetUpdateIntervalSeconds, nil)
+	if dpm.GetUpdateIntervalSecondsFunc != nil {
+		return dpm.GetUpdateIntervalSecondsFunc()
+	}
+	return 0
+}
+
 // CleanUpOrphanedDashboards not implemented for mocks
 func (dpm *ProvisionerMock) CleanUpOrphanedDashboards(ctx context.Context) {}
@@ -38,6 +38,8 @@ type FileReader struct {
 	mux                     sync.RWMutex
 	usageTracker            *usageTracker
 	dbWriteAccessRestricted bool
+	// limiter is shared by the readers of a provisioner to bound concurrent disk walks, nil means unlimited.
+	limiter chan struct{}
 }
 
 // NewDashboardFileReader returns a new filereader based on `config`
@@ -75,7 +77,7 @@ func (fr *FileReader) pollChanges(ctx context.Context) {
 	for {
 		select {
 		case <-ticker.C:
-			if err := fr.walkDisk(ctx); err != nil {
+			if err := fr.walkDiskLimited(ctx); err != nil {
 				fr.log.Error("failed to search for dashboards", "error", err)
 			}
 		case <-ctx.Done():
@@ -84,6 +86,21 @@ func (fr *FileReader) pollChanges(ctx context.Context) {
 	}
 }
 
+// walkDiskLimited runs walkDisk once the limiter has room for it. It returns without walking
+// the disk if the context is canceled while waiting.
+func (fr *FileReader) walkDiskLimited(ctx context.Context) error {
+	if fr.limiter != nil {
+		select {
+		case fr.limiter <- struct{}{}:
+			defer func() { <-fr.limiter }()
+		case <-ctx.Done():
+			return nil
+		}
+	}
+
+	return fr.walkDisk(ctx)
+}
+
 // walkDisk traverses the file system for the defined path, reading dashboard definition files,
 // and applies any change to the database.
 func (fr *FileReader) walkDisk(ctx context.Context) error {
@@ -423,6 +423,39 @@ func TestDashboardFileReader(t *testing.T) {
 		})
 	})
 
+	t.Run("Walking the disk with a concurrency limiter", func(t *testing.T) {
+		setup()
+		cfg.Options["path"] = oneDashboard
+		limitedService := &dashboards.FakeDashboardProvisioning{}
+		defer limitedService.AssertExpectations(t)
+
+		reader, err := NewDashboardFileReader(cfg, logger, nil, fakeStore)
+		require.NoError(t, err)
+		reader.dashboardProvisioningService = limitedService
+		reader.limiter = make(chan struct{}, 1)
+
+		t.Run("should not walk the disk while the limiter is full", func(t *testing.T) {
+			reader.limiter <- struct{}{}
+			defer func() { <-reader.limiter }()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+			defer cancel()
+
+			err := reader.walkDiskLimited(ctx)
+			require.NoError(t, err)
+			limitedService.AssertNotCalled(t, "GetProvisionedDashboardData", mock.Anything, configName)
+		})
+
+		t.Run("should walk the disk and release the limiter", func(t *testing.T) {
+			limitedService.On("GetProvisionedDashboardData", mock.Anything, configName).Return(nil, nil).Once()
+			limitedService.On("SaveProvisionedDashboard", mock.Anything, mock.Anything, mock.Anything).Return(&dashboards.Dashboard{}, nil).Once()
+
+			err := reader.walkDiskLimited(context.Background())
+			require.NoError(t, err)
+			require.Empty(t, reader.limiter)
+		})
+	})
+
 	t.Run("Given missing dashboard file", func(t *testing.T) {
 		absPath1, err := filepath.Abs(unprovision + "/dashboard1.json")
 		require.NoError(t, err)
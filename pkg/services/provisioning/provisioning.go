@@ -89,6 +89,7 @@ type ProvisioningService interface {
 	ProvisionAlerting(ctx context.Context) error
 	GetDashboardProvisionerResolvedPath(name string) string
 	GetAllowUIUpdatesFromConfig(name string) bool
+	GetDashboardProvisionerUpdateIntervalSeconds() int64
 }
 
 // Add a public constructor for overriding service to be able to instantiate OSS as fallback
@@ -239,7 +240,7 @@ func (ps *ProvisioningServiceImpl) ProvisionNotifications(ctx context.Context) e
 
 func (ps *ProvisioningServiceImpl) ProvisionDashboards(ctx context.Context) error {
 	dashboardPath := filepath.Join(ps.Cfg.ProvisioningPath, "dashboards")
-	dashProvisioner, err := ps.newDashboardProvisioner(ctx, dashboardPath, ps.dashboardProvisioningService, ps.orgService, ps.dashboardService)
+	dashProvisioner, err := ps.newDashboardProvisioner(ctx, dashboardPath, ps.Cfg.DashboardMaxConcurrentProvisioning, ps.dashboardProvisioningService, ps.orgService, ps.dashboardService)
 	if err != nil {
 		return fmt.Errorf("%v: %w", "Failed to create provisioner", err)
 	}
@@ -305,6 +306,15 @@ func (ps *ProvisioningServiceImpl) GetAllowUIUpdatesFromConfig(name string) bool
 	return ps.dashboardProvisioner.GetAllowUIUpdatesFromConfig(name)
 }
 
+// GetDashboardProvisionerUpdateIntervalSeconds returns the longest interval, in seconds, at which
+// provisioned dashboards are reloaded from disk.
+func (ps *ProvisioningServiceImpl) GetDashboardProvisionerUpdateIntervalSeconds() int64 {
+	if ps.dashboardProvisioner == nil {
+		return 0
+	}
+	return ps.dashboardProvisioner.GetUpdateIntervalSeconds()
+}
+
 func (ps *ProvisioningServiceImpl) cancelPolling() {
 	if ps.pollingCtxCancel != nil {
 		ps.log.Debug("Stop polling for dashboard changes")
@@ -3,27 +3,29 @@ package provisioning
 import "context"
 
 type Calls struct {
-	RunInitProvisioners                 []any
-	ProvisionDatasources                []any
-	ProvisionPlugins                    []any
-	ProvisionNotifications              []any
-	ProvisionDashboards                 []any
-	ProvisionAlerting                   []any
-	GetDashboardProvisionerResolvedPath []any
-	GetAllowUIUpdatesFromConfig         []any
-	Run                                 []any
+	RunInitProvisioners                          []any
+	ProvisionDatasources                         []any
+	ProvisionPlugins                             []any
+	ProvisionNotifications                       []any
+	ProvisionDashboards                          []any
+	ProvisionAlerting                            []any
+	GetDashboardProvisionerResolvedPath          []any
+	GetAllowUIUpdatesFromConfig                  []any
+	GetDashboardProvisionerUpdateIntervalSeconds []any
+	Run                                          []any
 }
 
 type ProvisioningServiceMock struct {
-	Calls                                   *Calls
-	RunInitProvisionersFunc                 func(ctx context.Context) error
-	ProvisionDatasourcesFunc                func(ctx context.Context) error
-	ProvisionPluginsFunc                    func() error
-	ProvisionNotificationsFunc              func() error
-	ProvisionDashboardsFunc                 func() error
-	GetDashboardProvisionerResolvedPathFunc func(name string) string
-	GetAllowUIUpdatesFromConfigFunc         func(name string) bool
-	RunFunc                                 func(ctx context.Context) error
+	Calls                                            *Calls
+	RunInitProvisionersFunc                          func(ctx context.Context) error
+	ProvisionDatasourcesFunc                         func(ctx context.Context) error
+	ProvisionPluginsFunc                             func() error
+	ProvisionNotificationsFunc                       func() error
+	ProvisionDashboardsFunc                          func() error
+	GetDashboardProvisionerResolvedPathFunc          func(name string) string
+	GetAllowUIUpdatesFromConfigFunc                  func(name string) bool
+	GetDashboardProvisionerUpdateIntervalSecondsFunc func() int64
+	RunFunc                                          func(ctx context.Context) error
 }
 
 func NewProvisioningServiceMock(ctx context.Context) *ProvisioningServiceMock {
@@ -93,6 +95,14 @@ func (mock *ProvisioningServiceMock) GetAllowUIUpdatesFromConfig(name string) bo
 	return false
 }
 
+func (mock *ProvisioningServiceMock) GetDashboardProvisionerUpdateIntervalSeconds() int64 {
+	mock.Calls.GetDashboardProvisionerUpdateIntervalSeconds = append(mock.Calls.GetDashboardProvisionerUpdateIntervalSeconds, nil)
+	if mock.GetDashboardProvisionerUpdateIntervalSecondsFunc != nil {
+		return mock.GetDashboardProvisionerUpdateIntervalSecondsFunc()
+	}
+	return 0
+}
+
 func (mock *ProvisioningServiceMock) Run(ctx context.Context) error {
 	mock.Calls.Run = append(mock.Calls.Run, nil)
 	if mock.RunFunc != nil {
@@ -18,8 +18,19 @@ const (
 	ScalingDownImages CapabilityName = "ScalingDownImages"
 	FullHeightImages  CapabilityName = "FullHeightImages"
 	SvgSanitization   CapabilityName = "SvgSanitization"
+	PDFRendering      CapabilityName = "PDFRendering"
+	CSVRendering      CapabilityName = "CSVRendering"
 )
 
+// formatCapabilities lists the render formats besides png and the capability the renderer needs to produce them.
+var formatCapabilities = []struct {
+	format     RenderType
+	capability CapabilityName
+}{
+	{format: RenderPDF, capability: PDFRendering},
+	{format: RenderCSV, capability: CSVRendering},
+}
+
 var ErrUnknownCapability = errors.New("unknown capability")
 var ErrInvalidPluginVersion = errors.New("invalid plugin version")
 
@@ -55,3 +66,19 @@ func (rs *RenderingService) HasCapability(ctx context.Context, capability Capabi
 
 	return CapabilitySupportRequestResult{IsSupported: compiledSemverConstraint.Check(compiledImageRendererVersion), SemverConstraint: semverConstraint}, nil
 }
+
+// SupportedFormats returns the formats the image renderer can produce. Every renderer produces png,
+// other formats depend on the renderer version. No formats are returned if no renderer is available.
+func SupportedFormats(ctx context.Context, rs Service) []RenderType {
+	if !rs.IsAvailable(ctx) {
+		return []RenderType{}
+	}
+
+	formats := []RenderType{RenderPNG}
+	for _, f := range formatCapabilities {
+		if result, err := rs.HasCapability(ctx, f.capability); err == nil && result.IsSupported {
+			formats = append(formats, f.format)
+		}
+	}
+	return formats
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/grafana/grafana/pkg/infra/log"
 	"github.com/grafana/grafana/pkg/plugins"
+	"github.com/grafana/grafana/pkg/services/featuremgmt"
 	"github.com/grafana/grafana/pkg/setting"
 )
 
@@ -135,3 +136,48 @@ func TestCapabilities(t *testing.T) {
 		})
 	}
 }
+
+func TestSupportedFormats(t *testing.T) {
+	cfg := setting.NewCfg()
+	cfg.ImagesDir = t.TempDir()
+	cfg.CSVsDir = t.TempDir()
+	rs, err := ProvideService(cfg, featuremgmt.WithFeatures(), nil, &dummyPluginManager{})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		rendererUrl     string
+		rendererVersion string
+		expected        []RenderType
+	}{
+		{
+			name:     "when image-renderer is not available",
+			expected: []RenderType{},
+		},
+		{
+			name:            "when image-renderer only supports png",
+			rendererUrl:     dummyRendererUrl,
+			rendererVersion: "2.0.0",
+			expected:        []RenderType{RenderPNG},
+		},
+		{
+			name:            "when image-renderer supports csv",
+			rendererUrl:     dummyRendererUrl,
+			rendererVersion: "3.4.0",
+			expected:        []RenderType{RenderPNG, RenderCSV},
+		},
+		{
+			name:            "when image-renderer supports pdf and csv",
+			rendererUrl:     dummyRendererUrl,
+			rendererVersion: "3.10.0",
+			expected:        []RenderType{RenderPNG, RenderPDF, RenderCSV},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rs.Cfg.RendererUrl = tt.rendererUrl
+			rs.version = tt.rendererVersion
+			require.Equal(t, tt.expected, SupportedFormats(context.Background(), rs))
+		})
+	}
+}
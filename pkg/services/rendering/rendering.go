@@ -113,6 +113,14 @@ func ProvideService(cfg *setting.Cfg, features *featuremgmt.FeatureManager, remo
 				name:             SvgSanitization,
 				semverConstraint: ">= 3.5.0",
 			},
+			{
+				name:             PDFRendering,
+				semverConstraint: ">= 3.10.0",
+			},
+			{
+				name:             CSVRendering,
+				semverConstraint: ">= 3.0.0",
+			},
 		},
 		Cfg:                   cfg,
 		features:              features,
@@ -43,6 +43,16 @@ func ProvideMigrateFromPluginService(
 }
 
 func (s *MigrateFromPluginService) Migrate(ctx context.Context) error {
+	setMigrationStatus(ctx, s.kvstore, secretskvs.MigrationStatusInProgress)
+	if err := s.migrate(ctx); err != nil {
+		setMigrationStatus(ctx, s.kvstore, secretskvs.MigrationStatusError)
+		return err
+	}
+	setMigrationStatus(ctx, s.kvstore, secretskvs.MigrationStatusComplete)
+	return nil
+}
+
+func (s *MigrateFromPluginService) migrate(ctx context.Context) error {
 	logger.Debug("starting migration of plugin secrets to unified secrets")
 	// access the plugin directly
 	plugin, err := secretskvs.StartAndReturnPlugin(s.manager, context.Background())
@@ -24,6 +24,7 @@ func TestPluginSecretMigrationService_MigrateFromPlugin(t *testing.T) {
 	t.Run("migrate secrets from secrets plugin to Grafana", func(t *testing.T) {
 		// --- SETUP
 		migratorService, plugin, sqlStore := setupTestMigrateFromPluginService(t)
+		validateMigrationStatus(t, migratorService.kvstore, ctx, secretskvs.MigrationStatusNotStarted)
 
 		addSecretToPluginStore(t, plugin, ctx, 1, "secret-1", "bogus", "value-1")
 		addSecretToPluginStore(t, plugin, ctx, 1, "secret-2", "bogus", "value-2")
@@ -37,6 +38,8 @@ func TestPluginSecretMigrationService_MigrateFromPlugin(t *testing.T) {
 
 		validateSecretWasStoredInSql(t, sqlStore, ctx, 1, "secret-1", "bogus", "value-1")
 		validateSecretWasStoredInSql(t, sqlStore, ctx, 1, "secret-2", "bogus", "value-2")
+
+		validateMigrationStatus(t, migratorService.kvstore, ctx, secretskvs.MigrationStatusComplete)
 	})
 }
 
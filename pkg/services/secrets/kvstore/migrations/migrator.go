@@ -5,9 +5,11 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/grafana/grafana/pkg/infra/kvstore"
 	"github.com/grafana/grafana/pkg/infra/log"
 	"github.com/grafana/grafana/pkg/infra/serverlock"
 	"github.com/grafana/grafana/pkg/registry"
+	secretskvs "github.com/grafana/grafana/pkg/services/secrets/kvstore"
 	"github.com/grafana/grafana/pkg/setting"
 )
 
@@ -103,3 +105,25 @@ func (s *SecretMigrationProviderImpl) TriggerPluginMigration(ctx context.Context
 		}
 	})
 }
+
+// setMigrationStatus persists the plugin migration progress so it can be reported to admins.
+// Failing to store the status must not fail the migration itself.
+func setMigrationStatus(ctx context.Context, kv kvstore.KVStore, status secretskvs.MigrationStatus) {
+	if err := secretskvs.SetPluginMigrationStatus(ctx, secretskvs.GetNamespacedKVStore(kv), status); err != nil {
+		logger.Error("Failed to persist plugin secrets migration status", "status", status, "error", err.Error())
+	}
+}
+
+// resetStaleMigrationStatus marks a migration that never finished as failed, so a crash during
+// a previous migration isn't reported as still in progress.
+func resetStaleMigrationStatus(ctx context.Context, kv kvstore.KVStore) {
+	status, err := secretskvs.GetPluginMigrationStatus(ctx, secretskvs.GetNamespacedKVStore(kv))
+	if err != nil {
+		logger.Warn("Failed to read plugin secrets migration status", "error", err.Error())
+		return
+	}
+	if status == secretskvs.MigrationStatusInProgress {
+		logger.Warn("Previous plugin secrets migration did not finish, marking it as failed")
+		setMigrationStatus(ctx, kv, secretskvs.MigrationStatusError)
+	}
+}
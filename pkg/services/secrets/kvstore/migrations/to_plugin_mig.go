@@ -45,9 +45,20 @@ func ProvideMigrateToPluginService(
 }
 
 func (s *MigrateToPluginService) Migrate(ctx context.Context) error {
+	// migrations run under a server lock, so a status still in progress was left behind by a crashed migration
+	resetStaleMigrationStatus(ctx, s.kvstore)
+	if err := s.migrate(ctx); err != nil {
+		setMigrationStatus(ctx, s.kvstore, secretskvs.MigrationStatusError)
+		return err
+	}
+	return nil
+}
+
+func (s *MigrateToPluginService) migrate(ctx context.Context) error {
 	err := secretskvs.EvaluateRemoteSecretsPlugin(ctx, s.manager, s.cfg)
 	hasStarted := secretskvs.HasPluginStarted(ctx, s.manager)
 	if err == nil && hasStarted {
+		setMigrationStatus(ctx, s.kvstore, secretskvs.MigrationStatusInProgress)
 		logger.Debug("starting migration of unified secrets to the plugin")
 		// we need to get the fallback store since in this scenario the secrets store would be the plugin.
 		tmpStore, err := secretskvs.GetUnwrappedStoreFromCache(s.secretsStore)
@@ -115,6 +126,7 @@ func (s *MigrateToPluginService) Migrate(ctx context.Context) error {
 			}
 		}
 		logger.Debug("deleted unified secrets after migration", "number of secrets", totalSec)
+		setMigrationStatus(ctx, s.kvstore, secretskvs.MigrationStatusComplete)
 	}
 	return nil
 }
@@ -46,6 +46,8 @@ func TestPluginSecretMigrationService_MigrateToPlugin(t *testing.T) {
 
 		validateSecretWasStoredInPlugin(t, secretsStore, ctx, orgId, namespace1, typ)
 		validateSecretWasStoredInPlugin(t, secretsStore, ctx, orgId, namespace1, typ)
+
+		validateMigrationStatus(t, migratorService.kvstore, ctx, secretskvs.MigrationStatusComplete)
 	})
 }
 
@@ -65,6 +67,30 @@ func TestFatalPluginErr_MigrationTestWithErrorDeletingUnifiedSecrets(t *testing.
 	isFatal, err := secretskvs.IsPluginStartupErrorFatal(context.Background(), secretskvs.GetNamespacedKVStore(p.KVStore))
 	assert.NoError(t, err)
 	assert.False(t, isFatal)
+
+	validateMigrationStatus(t, p.KVStore, context.Background(), secretskvs.MigrationStatusError)
+}
+
+func TestResetStaleMigrationStatus(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("a migration left in progress is marked as failed", func(t *testing.T) {
+		kv := kvstore.NewFakeKVStore()
+		require.NoError(t, secretskvs.SetPluginMigrationStatus(ctx, secretskvs.GetNamespacedKVStore(kv), secretskvs.MigrationStatusInProgress))
+
+		resetStaleMigrationStatus(ctx, kv)
+
+		validateMigrationStatus(t, kv, ctx, secretskvs.MigrationStatusError)
+	})
+
+	t.Run("a finished migration is left untouched", func(t *testing.T) {
+		kv := kvstore.NewFakeKVStore()
+		require.NoError(t, secretskvs.SetPluginMigrationStatus(ctx, secretskvs.GetNamespacedKVStore(kv), secretskvs.MigrationStatusComplete))
+
+		resetStaleMigrationStatus(ctx, kv)
+
+		validateMigrationStatus(t, kv, ctx, secretskvs.MigrationStatusComplete)
+	})
 }
 
 func addSecretToSqlStore(t *testing.T, sqlSecretStore secretskvs.SecretsKVStore, ctx context.Context, orgId int64, namespace1 string, typ string, value string) {
@@ -89,6 +115,14 @@ func validateSecretWasStoredInPlugin(t *testing.T, secretsStore secretskvs.Secre
 	require.Equal(t, 1, len(resPlugin))
 }
 
+// validates the persisted status of the plugin migration
+func validateMigrationStatus(t *testing.T, kv kvstore.KVStore, ctx context.Context, expected secretskvs.MigrationStatus) {
+	t.Helper()
+	status, err := secretskvs.GetPluginMigrationStatus(ctx, secretskvs.GetNamespacedKVStore(kv))
+	require.NoError(t, err)
+	require.Equal(t, expected, status)
+}
+
 // Set up services used in migration
 func setupTestMigrateToPluginService(t *testing.T) (*MigrateToPluginService, secretskvs.SecretsKVStore, secretskvs.SecretsKVStore) {
 	t.Helper()
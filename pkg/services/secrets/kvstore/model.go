@@ -6,10 +6,21 @@ import (
 
 const (
 	QuitOnPluginStartupFailureKey = "quit_on_secrets_plugin_startup_failure"
+	PluginMigrationStatusKey      = "secrets_plugin_migration_status"
 	PluginNamespace               = "secretsmanagerplugin"
 	DataSourceSecretType          = "datasource"
 )
 
+// MigrationStatus is the state of the last migration of secrets to or from the secrets manager plugin.
+type MigrationStatus string
+
+const (
+	MigrationStatusNotStarted MigrationStatus = "not_started"
+	MigrationStatusInProgress MigrationStatus = "in_progress"
+	MigrationStatusComplete   MigrationStatus = "complete"
+	MigrationStatusError      MigrationStatus = "error"
+)
+
 // Item stored in k/v store.
 type Item struct {
 	Id        int64
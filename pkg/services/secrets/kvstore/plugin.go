@@ -255,6 +255,23 @@ func SetPluginStartupErrorFatal(ctx context.Context, kvstore *kvstore.Namespaced
 	return kvstore.Set(ctx, QuitOnPluginStartupFailureKey, "true")
 }
 
+// GetPluginMigrationStatus returns the persisted status of the last plugin secrets migration.
+// If no migration has ever run, MigrationStatusNotStarted is returned.
+func GetPluginMigrationStatus(ctx context.Context, kvstore *kvstore.NamespacedKVStore) (MigrationStatus, error) {
+	status, exists, err := kvstore.Get(ctx, PluginMigrationStatusKey)
+	if err != nil {
+		return "", fmt.Errorf("error retrieving key %s from kvstore. error: %w", PluginMigrationStatusKey, err)
+	}
+	if !exists {
+		return MigrationStatusNotStarted, nil
+	}
+	return MigrationStatus(status), nil
+}
+
+func SetPluginMigrationStatus(ctx context.Context, kvstore *kvstore.NamespacedKVStore, status MigrationStatus) error {
+	return kvstore.Set(ctx, PluginMigrationStatusKey, string(status))
+}
+
 func EvaluateRemoteSecretsPlugin(ctx context.Context, mg plugins.SecretsPluginManager, cfg *setting.Cfg) error {
 	usePlugin := cfg.SectionWithEnvOverrides("secrets").Key("use_plugin").MustBool()
 	if !usePlugin {
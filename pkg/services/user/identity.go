@@ -30,6 +30,8 @@ type SignedInUser struct {
 	// IDToken is a signed token representing the identity that can be forwarded to plugins and external services.
 	// Will only be set when featuremgmt.FlagIdForwarding is enabled.
 	IDToken string `json:"-" xorm:"-"`
+	// MustChangePassword is set when an admin wants the user to change their password.
+	MustChangePassword bool
 }
 
 func (u *SignedInUser) ShouldUpdateLastSeenAt() bool {
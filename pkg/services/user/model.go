@@ -38,6 +38,10 @@ type User struct {
 	IsServiceAccount bool
 	OrgID            int64 `xorm:"org_id"`
 
+	// MustChangePassword is set when an admin resets the password and wants
+	// the user to choose a new one on their next login.
+	MustChangePassword bool
+
 	Created    time.Time
 	Updated    time.Time
 	LastSeenAt time.Time
@@ -80,7 +84,8 @@ type ChangeUserPasswordCommand struct {
 	OldPassword string `json:"oldPassword"`
 	NewPassword string `json:"newPassword"`
 
-	UserID int64 `json:"-"`
+	UserID             int64 `json:"-"`
+	MustChangePassword bool  `json:"-"`
 }
 
 type UpdateUserLastSeenAtCommand struct {
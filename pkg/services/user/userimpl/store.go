@@ -359,11 +359,14 @@ func (ss *sqlStore) Update(ctx context.Context, cmd *user.UpdateUserCommand) err
 func (ss *sqlStore) ChangePassword(ctx context.Context, cmd *user.ChangeUserPasswordCommand) error {
 	return ss.db.WithTransactionalDbSession(ctx, func(sess *db.Session) error {
 		user := user.User{
-			Password: cmd.NewPassword,
-			Updated:  time.Now(),
+			Password:           cmd.NewPassword,
+			Updated:            time.Now(),
+			MustChangePassword: cmd.MustChangePassword,
 		}
 
-		_, err := sess.ID(cmd.UserID).Where(ss.notServiceAccountFilter()).Update(&user)
+		_, err := sess.ID(cmd.UserID).Where(ss.notServiceAccountFilter()).
+			Cols("password", "updated", "must_change_password").
+			Update(&user)
 		return err
 	})
 }
@@ -400,6 +403,7 @@ func (ss *sqlStore) GetSignedInUser(ctx context.Context, query *user.GetSignedIn
 		u.is_disabled         as is_disabled,
 		u.help_flags1         as help_flags1,
 		u.last_seen_at        as last_seen_at,
+		u.must_change_password as must_change_password,
 		org.name              as org_name,
 		org_user.role         as org_role,
 		org.id                as org_id,
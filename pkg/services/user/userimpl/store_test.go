@@ -349,6 +349,36 @@ func TestIntegrationUserDataAccess(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("Change user password sets and clears must change password", func(t *testing.T) {
+		usr, err := userStore.Insert(context.Background(), &user.User{
+			Email:   "must_change@mail.com",
+			Login:   "must_change",
+			Created: time.Now(),
+			Updated: time.Now(),
+		})
+		require.NoError(t, err)
+
+		err = userStore.ChangePassword(context.Background(), &user.ChangeUserPasswordCommand{UserID: usr, NewPassword: "reset", MustChangePassword: true})
+		require.NoError(t, err)
+		result, err := userStore.GetByID(context.Background(), usr)
+		require.NoError(t, err)
+		require.True(t, result.MustChangePassword)
+		require.Equal(t, "reset", result.Password)
+		signedInUser, err := userStore.GetSignedInUser(context.Background(), &user.GetSignedInUserQuery{UserID: usr})
+		require.NoError(t, err)
+		require.True(t, signedInUser.MustChangePassword)
+
+		err = userStore.ChangePassword(context.Background(), &user.ChangeUserPasswordCommand{UserID: usr, NewPassword: "changed"})
+		require.NoError(t, err)
+		result, err = userStore.GetByID(context.Background(), usr)
+		require.NoError(t, err)
+		require.False(t, result.MustChangePassword)
+		require.Equal(t, "changed", result.Password)
+		signedInUser, err = userStore.GetSignedInUser(context.Background(), &user.GetSignedInUserQuery{UserID: usr})
+		require.NoError(t, err)
+		require.False(t, signedInUser.MustChangePassword)
+	})
+
 	t.Run("update last seen at", func(t *testing.T) {
 		err := userStore.UpdateLastSeenAt(context.Background(), &user.UpdateUserLastSeenAtCommand{
 			UserID: 10, // Requires UserID
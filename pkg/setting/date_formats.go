@@ -1,6 +1,8 @@
 package setting
 
 import (
+	"encoding/json"
+	"strings"
 	"time"
 
 	"gopkg.in/ini.v1"
@@ -12,6 +14,8 @@ type DateFormats struct {
 	Interval         DateFormatIntervals `json:"interval"`
 	DefaultTimezone  string              `json:"defaultTimezone"`
 	DefaultWeekStart string              `json:"defaultWeekStart"`
+	// IntervalFormats overrides the interval formats per unit, e.g. {"day": "ddd MM/DD"}.
+	IntervalFormats map[string]string `json:"intervalFormats,omitempty"`
 }
 
 type DateFormatIntervals struct {
@@ -26,6 +30,17 @@ type DateFormatIntervals struct {
 
 const localBrowser = "browser"
 
+// intervalUnits are the units that interval formats can be set for.
+var intervalUnits = map[string]bool{
+	"millisecond": true,
+	"second":      true,
+	"minute":      true,
+	"hour":        true,
+	"day":         true,
+	"month":       true,
+	"year":        true,
+}
+
 func valueAsTimezone(section *ini.Section, keyName string) (string, error) {
 	timezone := section.Key(keyName).MustString(localBrowser)
 	if timezone == localBrowser {
@@ -58,4 +73,35 @@ func (cfg *Cfg) readDateFormats() {
 	}
 	cfg.DateFormats.DefaultTimezone = timezone
 	cfg.DateFormats.DefaultWeekStart = valueAsString(dateFormats, "default_week_start", "browser")
+	cfg.DateFormats.IntervalFormats = cfg.readIntervalFormats(dateFormats.Key("interval_formats").String())
+}
+
+// readIntervalFormats parses the interval format overrides, unknown units and empty formats are ignored.
+func (cfg *Cfg) readIntervalFormats(value string) map[string]string {
+	if strings.TrimSpace(value) == "" {
+		return nil
+	}
+
+	var formats map[string]string
+	if err := json.Unmarshal([]byte(value), &formats); err != nil {
+		cfg.Logger.Error("Failed to parse interval_formats, ignoring it", "error", err)
+		return nil
+	}
+
+	intervalFormats := make(map[string]string, len(formats))
+	for unit, format := range formats {
+		unit = strings.ToLower(strings.TrimSpace(unit))
+		if !intervalUnits[unit] {
+			cfg.Logger.Warn("Unknown unit in interval_formats, ignoring it", "unit", unit)
+			continue
+		}
+		if format = strings.TrimSpace(format); format != "" {
+			intervalFormats[unit] = format
+		}
+	}
+
+	if len(intervalFormats) == 0 {
+		return nil
+	}
+	return intervalFormats
 }
@@ -33,3 +33,28 @@ func TestValueAsTimezone(t *testing.T) {
 		assert.Equal(t, expected.output, output, "Invalid output for input %q", input)
 	}
 }
+
+func TestReadIntervalFormats(t *testing.T) {
+	cfg := NewCfg()
+
+	tests := map[string]map[string]string{
+		"":         nil,
+		"not json": nil,
+		`{}`:       nil,
+		`{"day": "ddd MM/DD", "Hour": "ddd HH:mm"}`: {"day": "ddd MM/DD", "hour": "ddd HH:mm"},
+		`{"week": "ddd", "day": " "}`:               nil,
+	}
+
+	for input, expected := range tests {
+		assert.Equal(t, expected, cfg.readIntervalFormats(input), "Invalid output for input %q", input)
+	}
+}
+
+func TestReadDateFormats_IntervalFormats(t *testing.T) {
+	cfg := NewCfg()
+	err := cfg.Load(CommandLineArgs{HomePath: "../../", Args: []string{`cfg:date_formats.interval_formats={"day": "ddd MM/DD"}`}})
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{"day": "ddd MM/DD"}, cfg.DateFormats.IntervalFormats)
+	assert.Equal(t, "MM/DD", cfg.DateFormats.Interval.Day)
+}
@@ -0,0 +1,19 @@
+package setting
+
+import (
+	"gopkg.in/ini.v1"
+)
+
+// WithinLimit reports whether count doesn't exceed limit. A limit of 0 or less means unlimited.
+func WithinLimit(count, limit int) bool {
+	return limit <= 0 || count <= limit
+}
+
+// readLimit reads a limit where 0 means unlimited, negative values are treated as unlimited as well.
+func readLimit(section *ini.Section, key string) int {
+	limit := section.Key(key).MustInt(0)
+	if limit < 0 {
+		return 0
+	}
+	return limit
+}
@@ -0,0 +1,30 @@
+package setting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/ini.v1"
+)
+
+func TestWithinLimit(t *testing.T) {
+	assert.True(t, WithinLimit(1000, 0), "zero is unlimited")
+	assert.True(t, WithinLimit(1000, -1), "negative is unlimited")
+	assert.True(t, WithinLimit(10, 10))
+	assert.False(t, WithinLimit(11, 10))
+}
+
+func TestReadLimit(t *testing.T) {
+	sec, err := ini.Empty().NewSection("dashboards")
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, readLimit(sec, "max_panels"))
+
+	_, err = sec.NewKey("max_panels", "100")
+	require.NoError(t, err)
+	assert.Equal(t, 100, readLimit(sec, "max_panels"))
+
+	sec.Key("max_panels").SetValue("-5")
+	assert.Equal(t, 0, readLimit(sec, "max_panels"))
+}
@@ -83,6 +83,7 @@ var (
 	// Security settings.
 	SecretKey              string
 	DisableGravatar        bool
+	AvatarSourceURL        string
 	DataProxyWhiteList     map[string]bool
 	CookieSecure           bool
 	CookieSameSiteDisabled bool
@@ -221,6 +222,8 @@ type Cfg struct {
 	StrictTransportSecurityMaxAge     int
 	StrictTransportSecurityPreload    bool
 	StrictTransportSecuritySubDomains bool
+	// MaxEmbedRequestsPerMinute limits the queries per minute of an embedded panel, 0 means unlimited.
+	MaxEmbedRequestsPerMinute int
 	// CSPEnabled toggles Content Security Policy support.
 	CSPEnabled bool
 	// CSPTemplate contains the Content Security Policy template.
@@ -253,6 +256,13 @@ type Cfg struct {
 
 	// Panels
 	DisableSanitizeHtml bool
+	Panels              PanelsSettings
+
+	// Explore
+	Explore ExploreSettings
+
+	// Navigation
+	Navigation NavigationSettings
 
 	// Metrics
 	MetricsEndpointEnabled           bool
@@ -268,6 +278,36 @@ type Cfg struct {
 
 	// Dashboards
 	DefaultHomeDashboardPath string
+	// DashboardMaxTemplateVariables is the maximum number of template variables per dashboard, 0 means unlimited.
+	DashboardMaxTemplateVariables int
+	// DashboardMaxPanels is the maximum number of panels per dashboard, rows excluded, 0 means unlimited.
+	DashboardMaxPanels int
+	// DashboardPauseRefreshWhileEditing pauses dashboard auto-refresh while a panel is being edited.
+	DashboardPauseRefreshWhileEditing bool
+	// DashboardMaxRefreshesBeforePause is the number of auto-refreshes without user interaction after which
+	// auto-refresh pauses until the user interacts, 0 means it never pauses.
+	DashboardMaxRefreshesBeforePause int
+	// DashboardMinVariableRefreshIntervalSeconds is the minimum time between time range refreshes of a template variable, 0 means no minimum.
+	DashboardMinVariableRefreshIntervalSeconds int
+	// DashboardDefaultAllValue is the custom "All" value of new template variables, empty keeps the wildcard.
+	DashboardDefaultAllValue string
+	// DashboardMaxRenderQueue is the number of dashboards a playlist renders before reloading the page, 0 uses the default.
+	DashboardMaxRenderQueue int
+	// DashboardMaxFolderTreeNodes is the number of items folder trees load at a time, 0 uses the default.
+	DashboardMaxFolderTreeNodes int
+	// DashboardDefaultLinkIcon is the icon of new dashboard links, e.g. "info".
+	DashboardDefaultLinkIcon string
+	// DashboardDefaultLinkTooltip is the tooltip of new dashboard links.
+	DashboardDefaultLinkTooltip string
+	// DashboardDefaultDatasourceVariableFilter is the data source type of new data source variables,
+	// optionally followed by a colon and an instance name regex, e.g. "prometheus:/^prod/".
+	DashboardDefaultDatasourceVariableFilter string
+	// DashboardDefaultVariableMultiSelect enables multi-select on new template variables that support it.
+	DashboardDefaultVariableMultiSelect bool
+	// DashboardMaxJSONFields is the number of JSON model fields above which the dashboard editor and import warn, 0 means unlimited.
+	DashboardMaxJSONFields int
+	// DashboardMaxConcurrentProvisioning is the number of dashboard providers that may sync from disk at the same time, 0 means unlimited.
+	DashboardMaxConcurrentProvisioning int
 
 	// Auth
 	LoginCookieName              string
@@ -284,7 +324,10 @@ type Cfg struct {
 	DisableLogin                 bool
 	AdminEmail                   string
 	DisableLoginForm             bool
+	DisableResetPassword         bool
 	SignoutRedirectUrl           string
+	AutoLogoutOnIdle             bool
+	IdleTimeout                  time.Duration
 	// Not documented & not supported
 	// stand in until a more complete solution is implemented
 	AuthConfigUIAdminAccess bool
@@ -375,6 +418,7 @@ type Cfg struct {
 	UserInviteMaxLifetime time.Duration
 	HiddenUsers           map[string]struct{}
 	CaseInsensitiveLogin  bool // Login and Email will be considered case insensitive
+	TeamPickerPageSize    int  // 0 uses the frontend default
 
 	// Service Accounts
 	SATokenExpirationDayLimit int
@@ -386,11 +430,20 @@ type Cfg struct {
 	DashboardAnnotationCleanupSettings AnnotationCleanupSettings
 	APIAnnotationCleanupSettings       AnnotationCleanupSettings
 
+	// AnnotationMaxConcurrentQueries limits the annotation queries a dashboard runs at the same time, 0 means unlimited.
+	AnnotationMaxConcurrentQueries int
+	// AnnotationMaxRendered is the number of annotation markers a panel renders before clustering them, 0 means unlimited.
+	AnnotationMaxRendered int
+
 	// GrafanaJavascriptAgent config
 	GrafanaJavascriptAgent GrafanaJavascriptAgent
 
 	// Data sources
 	DataSourceLimit int
+	// DataSourceMaxFrontendConnections is the maximum number of data source requests the browser runs in parallel, 0 uses the browser default.
+	DataSourceMaxFrontendConnections int
+	// DataSourceMaxInstancesPerPlugin is the number of data sources of one plugin above which adding another warns, 0 means unlimited.
+	DataSourceMaxInstancesPerPlugin int
 
 	// SQL Data sources
 	SqlDatasourceMaxOpenConnsDefault    int
@@ -403,6 +456,8 @@ type Cfg struct {
 	ExternalSnapshotName  string
 	ExternalEnabled       bool
 	SnapShotRemoveExpired bool
+	// MaxExternalSnapshots is the maximum number of external snapshots, 0 means unlimited.
+	MaxExternalSnapshots int
 
 	SnapshotPublicMode bool
 
@@ -424,6 +479,11 @@ type Cfg struct {
 	ApplicationInsightsEndpointUrl      string
 	FeedbackLinksEnabled                bool
 
+	// ApplicationInsightsSamplingPercentage is the percentage of telemetry sent by the browser SDK
+	ApplicationInsightsSamplingPercentage float64
+	// ApplicationInsightsEnabledForRoles are the org roles Application Insights is enabled for, empty means all roles
+	ApplicationInsightsEnabledForRoles []string
+
 	// Frontend analytics
 	GoogleAnalyticsID                   string
 	GoogleAnalytics4ID                  string
@@ -435,6 +495,10 @@ type Cfg struct {
 	RudderstackConfigURL                string
 	RudderstackIntegrationsURL          string
 	IntercomSecret                      string
+	// AnalyticsKeysRequireEnabled only sends the frontend analytics keys when analytics are enabled
+	AnalyticsKeysRequireEnabled bool
+	// AnalyticsKeysHideFromAnonymous doesn't send the frontend analytics keys to anonymous users
+	AnalyticsKeysHideFromAnonymous bool
 
 	// AzureAD
 	AzureADEnabled         bool
@@ -517,6 +581,10 @@ type Cfg struct {
 	// Unified Alerting
 	UnifiedAlerting UnifiedAlertingSettings
 
+	// Query
+	// QueryMaxVariables is the number of distinct template variables a query can reference before the query editor warns, 0 means unlimited.
+	QueryMaxVariables int
+
 	// Query history
 	QueryHistoryEnabled bool
 
@@ -730,6 +798,8 @@ func (cfg *Cfg) readAnnotationSettings() error {
 		cfg.Logger.Warn("[annotations.tags_length] is too low; the minimum allowed (500) is enforced")
 		cfg.AnnotationMaximumTagsLength = 500
 	}
+	cfg.AnnotationMaxConcurrentQueries = readLimit(section, "max_concurrent_queries")
+	cfg.AnnotationMaxRendered = readLimit(section, "max_rendered")
 
 	dashboardAnnotation := cfg.Raw.Section("annotations.dashboard")
 	apiIAnnotation := cfg.Raw.Section("annotations.api")
@@ -974,6 +1044,9 @@ func (cfg *Cfg) setHomePath(args CommandLineArgs) {
 
 var skipStaticRootValidation = false
 
+// dashboardLinkIcons are the icons a dashboard link can have, see linkIconMap in the frontend.
+var dashboardLinkIcons = []string{"external link", "dashboard", "question", "info", "bolt", "doc", "cloud"}
+
 func NewCfg() *Cfg {
 	return &Cfg{
 		Target: []string{"all"},
@@ -1077,6 +1150,24 @@ func (cfg *Cfg) Load(args CommandLineArgs) error {
 	MinRefreshInterval = valueAsString(dashboards, "min_refresh_interval", "5s")
 
 	cfg.DefaultHomeDashboardPath = dashboards.Key("default_home_dashboard_path").MustString("")
+	cfg.DashboardMaxTemplateVariables = readLimit(dashboards, "max_template_variables")
+	cfg.DashboardMaxPanels = readLimit(dashboards, "max_panels")
+	cfg.DashboardPauseRefreshWhileEditing = dashboards.Key("pause_refresh_while_editing").MustBool(false)
+	cfg.DashboardMaxRefreshesBeforePause = readLimit(dashboards, "max_refreshes_before_pause")
+	cfg.DashboardMinVariableRefreshIntervalSeconds = readLimit(dashboards, "min_variable_refresh_interval_seconds")
+	cfg.DashboardDefaultAllValue = valueAsString(dashboards, "default_all_value", "")
+	cfg.DashboardMaxRenderQueue = readLimit(dashboards, "max_render_queue")
+	cfg.DashboardMaxFolderTreeNodes = readLimit(dashboards, "max_folder_tree_nodes")
+	cfg.DashboardDefaultLinkIcon = dashboards.Key("default_link_icon").In("", dashboardLinkIcons)
+	cfg.DashboardDefaultLinkTooltip = valueAsString(dashboards, "default_link_tooltip", "")
+	cfg.DashboardDefaultDatasourceVariableFilter = valueAsString(dashboards, "default_datasource_variable_filter", "")
+	if strings.HasPrefix(cfg.DashboardDefaultDatasourceVariableFilter, ":") {
+		cfg.Logger.Warn("default_datasource_variable_filter must start with a data source type, ignoring it", "value", cfg.DashboardDefaultDatasourceVariableFilter)
+		cfg.DashboardDefaultDatasourceVariableFilter = ""
+	}
+	cfg.DashboardDefaultVariableMultiSelect = dashboards.Key("default_variable_multi_select").MustBool(false)
+	cfg.DashboardMaxJSONFields = readLimit(dashboards, "max_json_fields")
+	cfg.DashboardMaxConcurrentProvisioning = readLimit(dashboards, "max_concurrent_provisioning")
 
 	if err := readUserSettings(iniFile, cfg); err != nil {
 		return err
@@ -1117,6 +1208,8 @@ func (cfg *Cfg) Load(args CommandLineArgs) error {
 	cfg.RudderstackConfigURL = analytics.Key("rudderstack_config_url").String()
 	cfg.RudderstackIntegrationsURL = analytics.Key("rudderstack_integrations_url").String()
 	cfg.IntercomSecret = analytics.Key("intercom_secret").String()
+	cfg.AnalyticsKeysRequireEnabled = analytics.Key("frontend_keys_require_enabled").MustBool(false)
+	cfg.AnalyticsKeysHideFromAnonymous = analytics.Key("hide_frontend_keys_from_anonymous").MustBool(false)
 
 	cfg.ReportingEnabled = analytics.Key("reporting_enabled").MustBool(true)
 	cfg.ReportingDistributor = analytics.Key("reporting_distributor").MustString("grafana-labs")
@@ -1127,6 +1220,14 @@ func (cfg *Cfg) Load(args CommandLineArgs) error {
 
 	cfg.ApplicationInsightsConnectionString = analytics.Key("application_insights_connection_string").String()
 	cfg.ApplicationInsightsEndpointUrl = analytics.Key("application_insights_endpoint_url").String()
+	cfg.ApplicationInsightsSamplingPercentage = analytics.Key("application_insights_sampling_percentage").MustFloat64(100)
+	if cfg.ApplicationInsightsSamplingPercentage < 0 || cfg.ApplicationInsightsSamplingPercentage > 100 {
+		cfg.Logger.Warn("application_insights_sampling_percentage must be between 0 and 100, using 100", "value", cfg.ApplicationInsightsSamplingPercentage)
+		cfg.ApplicationInsightsSamplingPercentage = 100
+	}
+	if cfg.ApplicationInsightsEnabledForRoles, err = readEnabledForRoles(analytics, "application_insights_enabled_for_roles"); err != nil {
+		return err
+	}
 	cfg.FeedbackLinksEnabled = analytics.Key("feedback_links_enabled").MustBool(true)
 
 	if err := readAlertingSettings(iniFile); err != nil {
@@ -1145,6 +1246,8 @@ func (cfg *Cfg) Load(args CommandLineArgs) error {
 	news := iniFile.Section("news")
 	NewsFeedEnabled = news.Key("news_feed_enabled").MustBool(true)
 
+	cfg.QueryMaxVariables = readLimit(iniFile.Section("query"), "max_variables_per_query")
+
 	queryHistory := iniFile.Section("query_history")
 	cfg.QueryHistoryEnabled = queryHistory.Key("enabled").MustBool(true)
 
@@ -1168,6 +1271,9 @@ func (cfg *Cfg) Load(args CommandLineArgs) error {
 		cfg.PluginsEnableAlpha = true
 	}
 
+	cfg.readPanelsSettings()
+	cfg.readExploreSettings()
+	cfg.readNavigationSettings()
 	cfg.readSAMLConfig()
 	cfg.readLDAPConfig()
 	cfg.handleAWSConfig()
@@ -1190,6 +1296,7 @@ func (cfg *Cfg) Load(args CommandLineArgs) error {
 
 	cfg.Storage = readStorageSettings(iniFile)
 	cfg.Search = readSearchSettings(iniFile)
+	cfg.validateWhiteLabelingFooterLinks()
 
 	cfg.SecureSocksDSProxy, err = readSecureSocksDSProxySettings(iniFile)
 	if err != nil {
@@ -1245,7 +1352,9 @@ func (cfg *Cfg) Load(args CommandLineArgs) error {
 	cfg.GeomapEnableCustomBaseLayers = geomapSection.Key("enable_custom_baselayers").MustBool(true)
 
 	cfg.readDateFormats()
-	cfg.readGrafanaJavascriptAgentConfig()
+	if err := cfg.readGrafanaJavascriptAgentConfig(); err != nil {
+		return err
+	}
 
 	if err := cfg.readLiveSettings(iniFile); err != nil {
 		return err
@@ -1406,6 +1515,7 @@ func readSecuritySettings(iniFile *ini.File, cfg *Cfg) error {
 	SecretKey = valueAsString(security, "secret_key", "")
 	cfg.SecretKey = SecretKey
 	DisableGravatar = security.Key("disable_gravatar").MustBool(true)
+	AvatarSourceURL = cfg.readAvatarSourceURL(security.Key("avatar_source_url").String())
 	cfg.DisableBruteForceLoginProtection = security.Key("disable_brute_force_login_protection").MustBool(false)
 
 	CookieSecure = security.Key("cookie_secure").MustBool(false)
@@ -1432,6 +1542,7 @@ func readSecuritySettings(iniFile *ini.File, cfg *Cfg) error {
 		}
 	}
 	cfg.AllowEmbedding = security.Key("allow_embedding").MustBool(false)
+	cfg.MaxEmbedRequestsPerMinute = readLimit(security, "max_embed_requests_per_minute")
 
 	cfg.ContentTypeProtectionHeader = security.Key("x_content_type_options").MustBool(true)
 	cfg.XSSProtectionHeader = security.Key("x_xss_protection").MustBool(true)
@@ -1553,6 +1664,7 @@ func readAuthSettings(iniFile *ini.File, cfg *Cfg) (err error) {
 	cfg.AuthConfigUIAdminAccess = auth.Key("config_ui_admin_access").MustBool(false)
 
 	cfg.DisableLoginForm = auth.Key("disable_login_form").MustBool(false)
+	cfg.DisableResetPassword = auth.Key("disable_reset_password").MustBool(false)
 	DisableSignoutMenu = auth.Key("disable_signout_menu").MustBool(false)
 
 	// Deprecated
@@ -1563,6 +1675,15 @@ func readAuthSettings(iniFile *ini.File, cfg *Cfg) (err error) {
 
 	cfg.OAuthCookieMaxAge = auth.Key("oauth_state_cookie_max_age").MustInt(600)
 	cfg.SignoutRedirectUrl = valueAsString(auth, "signout_redirect_url", "")
+
+	cfg.AutoLogoutOnIdle = auth.Key("auto_logout_on_idle").MustBool(false)
+	const defaultIdleTimeout = "15m"
+	idleTimeoutVal := valueAsString(auth, "idle_timeout", defaultIdleTimeout)
+	cfg.IdleTimeout, err = gtime.ParseDuration(idleTimeoutVal)
+	if err != nil {
+		return err
+	}
+
 	// Deprecated
 	cfg.OAuthSkipOrgRoleUpdateSync = auth.Key("oauth_skip_org_role_update_sync").MustBool(false)
 	if cfg.OAuthSkipOrgRoleUpdateSync {
@@ -1725,6 +1846,11 @@ func readUserSettings(iniFile *ini.File, cfg *Cfg) error {
 		}
 	}
 
+	cfg.TeamPickerPageSize = users.Key("team_picker_page_size").MustInt(0)
+	if cfg.TeamPickerPageSize < 0 {
+		cfg.TeamPickerPageSize = 0
+	}
+
 	return nil
 }
 
@@ -1862,6 +1988,7 @@ func readSnapshotsSettings(cfg *Cfg, iniFile *ini.File) error {
 	cfg.ExternalSnapshotName = valueAsString(snapshots, "external_snapshot_name", "")
 
 	cfg.ExternalEnabled = snapshots.Key("external_enabled").MustBool(true)
+	cfg.MaxExternalSnapshots = readLimit(snapshots, "max_external_snapshots")
 	cfg.SnapShotRemoveExpired = snapshots.Key("snapshot_remove_expired").MustBool(true)
 	cfg.SnapshotPublicMode = snapshots.Key("public_mode").MustBool(false)
 
@@ -1958,6 +2085,8 @@ func (cfg *Cfg) GetContentDeliveryURL(prefix string) string {
 func (cfg *Cfg) readDataSourcesSettings() {
 	datasources := cfg.Raw.Section("datasources")
 	cfg.DataSourceLimit = datasources.Key("datasource_limit").MustInt(5000)
+	cfg.DataSourceMaxFrontendConnections = readLimit(datasources, "max_frontend_connections")
+	cfg.DataSourceMaxInstancesPerPlugin = readLimit(datasources, "max_instances_per_plugin")
 }
 
 func (cfg *Cfg) readSqlDataSourceSettings() {
@@ -2011,3 +2140,17 @@ func (cfg *Cfg) readLiveSettings(iniFile *ini.File) error {
 	cfg.LiveAllowedOrigins = originPatterns
 	return nil
 }
+
+// readEnabledForRoles reads a list of org roles a frontend integration is enabled for.
+// An empty list enables the integration for all roles. Unknown roles are an error, so
+// that a typo can't expose the integration to more roles than intended.
+func readEnabledForRoles(section *ini.Section, key string) ([]string, error) {
+	roles := make([]string, 0)
+	for _, role := range util.SplitString(section.Key(key).String()) {
+		if !roletype.RoleType(role).IsValid() {
+			return nil, fmt.Errorf("invalid role %q in [%s] %s", role, section.Name(), key)
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
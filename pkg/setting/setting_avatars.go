@@ -0,0 +1,52 @@
+package setting
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// AvatarHashPlaceholder is replaced by the avatar hash in the custom avatar source URL.
+const AvatarHashPlaceholder = "{hash}"
+
+// AvatarProxyEnabled reports whether avatars are served by the /avatar/:hash proxy,
+// which is the case when gravatar is enabled or a custom avatar source is configured.
+func AvatarProxyEnabled() bool {
+	return !DisableGravatar || AvatarSourceURL != ""
+}
+
+// AvatarSourceURLForHash returns the URL to fetch the avatar with the given hash from a custom avatar source.
+func AvatarSourceURLForHash(source string, hash string) string {
+	if strings.Contains(source, AvatarHashPlaceholder) {
+		return strings.ReplaceAll(source, AvatarHashPlaceholder, url.PathEscape(hash))
+	}
+	return source + url.PathEscape(hash)
+}
+
+// readAvatarSourceURL returns the custom avatar source, or an empty string if it isn't an absolute http(s) URL.
+func (cfg *Cfg) readAvatarSourceURL(source string) string {
+	source = strings.TrimSpace(source)
+	if source == "" {
+		return ""
+	}
+
+	if err := validateAvatarSourceURL(source); err != nil {
+		cfg.Logger.Error("Invalid avatar_source_url, falling back to gravatar settings", "error", err)
+		return ""
+	}
+	return source
+}
+
+func validateAvatarSourceURL(source string) error {
+	u, err := url.Parse(strings.ReplaceAll(source, AvatarHashPlaceholder, "hash"))
+	if err != nil {
+		return fmt.Errorf("invalid url %q: %w", source, err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("url %q must use http or https", source)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("url %q has no host", source)
+	}
+	return nil
+}
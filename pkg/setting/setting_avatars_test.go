@@ -0,0 +1,21 @@
+package setting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAvatarSourceURLForHash(t *testing.T) {
+	assert.Equal(t, "https://avatars.example.com/api/abc.png?size=200", AvatarSourceURLForHash("https://avatars.example.com/api/{hash}.png?size=200", "abc"))
+	assert.Equal(t, "https://avatars.example.com/avatar/abc", AvatarSourceURLForHash("https://avatars.example.com/avatar/", "abc"))
+}
+
+func TestReadAvatarSourceURL(t *testing.T) {
+	cfg := NewCfg()
+
+	assert.Equal(t, "", cfg.readAvatarSourceURL(""))
+	assert.Equal(t, "https://avatars.example.com/{hash}", cfg.readAvatarSourceURL(" https://avatars.example.com/{hash} "))
+	assert.Equal(t, "", cfg.readAvatarSourceURL("javascript:alert(1)//{hash}"))
+	assert.Equal(t, "", cfg.readAvatarSourceURL("/avatars/{hash}"))
+}
@@ -0,0 +1,118 @@
+package setting
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// exploreVisualizations are the visualizations Explore can show a data frame with, see PreferredVisualisationType in @grafana/data.
+var exploreVisualizations = []string{"graph", "table", "logs", "trace", "nodeGraph", "flamegraph", "rawPrometheus"}
+
+// ExploreSettings contains the Explore defaults sent to the frontend.
+// Zero values keep the built-in frontend defaults.
+type ExploreSettings struct {
+	// LogLevelColors maps lowercase log level names to the color they're shown with.
+	LogLevelColors map[string]string
+	// MaxSessionQueryHistory caps the number of queries Explore keeps in memory per session.
+	MaxSessionQueryHistory int
+	// DefaultVizByDatasourceType maps data source types to the visualization Explore shows their results with
+	// when the response doesn't specify one.
+	DefaultVizByDatasourceType map[string]string
+	// DefaultRangeSelectAction is what selecting a time range in the graph does, one of "zoom", "annotate" or "copy".
+	DefaultRangeSelectAction string
+	// DisableSupplementaryQueries turns off supplementary queries, such as logs volume, until users enable them.
+	DisableSupplementaryQueries bool
+	// RowLimitByDatasourceType maps data source types to the row limit of their Explore queries.
+	RowLimitByDatasourceType map[string]int
+}
+
+func (cfg *Cfg) readExploreSettings() {
+	explore := cfg.Raw.Section("explore")
+	cfg.Explore.MaxSessionQueryHistory = readLimit(explore, "max_session_query_history")
+	cfg.Explore.DefaultRangeSelectAction = explore.Key("default_range_select_action").In("", []string{"zoom", "annotate", "copy"})
+	cfg.Explore.DisableSupplementaryQueries = !explore.Key("default_supplementary_queries").MustBool(true)
+
+	cfg.Explore.LogLevelColors = map[string]string{}
+	if colorsJSON := valueAsString(explore, "log_level_colors", ""); colorsJSON != "" {
+		colors, err := parseLogLevelColors(colorsJSON)
+		if err != nil {
+			cfg.Logger.Error("Error reading json from log_level_colors", "error", err)
+		} else {
+			cfg.Explore.LogLevelColors = colors
+		}
+	}
+
+	cfg.Explore.DefaultVizByDatasourceType = nil
+	if vizJSON := valueAsString(explore, "default_viz_by_datasource_type", ""); vizJSON != "" {
+		vizByType, err := parseVizByDatasourceType(vizJSON)
+		if err != nil {
+			cfg.Logger.Error("Error reading json from default_viz_by_datasource_type", "error", err)
+		} else {
+			cfg.Explore.DefaultVizByDatasourceType = vizByType
+		}
+	}
+
+	cfg.Explore.RowLimitByDatasourceType = nil
+	if limitsJSON := valueAsString(explore, "row_limit_by_datasource_type", ""); limitsJSON != "" {
+		limitByType, err := parseRowLimitByDatasourceType(limitsJSON)
+		if err != nil {
+			cfg.Logger.Error("Error reading json from row_limit_by_datasource_type", "error", err)
+		} else {
+			cfg.Explore.RowLimitByDatasourceType = limitByType
+		}
+	}
+}
+
+func parseLogLevelColors(colorsJSON string) (map[string]string, error) {
+	var colors map[string]string
+	if err := json.Unmarshal([]byte(colorsJSON), &colors); err != nil {
+		return nil, err
+	}
+
+	levelColors := make(map[string]string, len(colors))
+	for level, color := range colors {
+		level = strings.ToLower(strings.TrimSpace(level))
+		if level == "" || color == "" {
+			return nil, errors.New("every log level needs a name and a color")
+		}
+		levelColors[level] = color
+	}
+	return levelColors, nil
+}
+
+func parseVizByDatasourceType(vizJSON string) (map[string]string, error) {
+	var vizByType map[string]string
+	if err := json.Unmarshal([]byte(vizJSON), &vizByType); err != nil {
+		return nil, err
+	}
+
+	for dsType, viz := range vizByType {
+		if strings.TrimSpace(dsType) == "" {
+			return nil, errors.New("every visualization needs a data source type")
+		}
+		if !slices.Contains(exploreVisualizations, viz) {
+			return nil, fmt.Errorf("unknown visualization %q for data source type %q", viz, dsType)
+		}
+	}
+	return vizByType, nil
+}
+
+func parseRowLimitByDatasourceType(limitsJSON string) (map[string]int, error) {
+	var limitByType map[string]int
+	if err := json.Unmarshal([]byte(limitsJSON), &limitByType); err != nil {
+		return nil, err
+	}
+
+	for dsType, limit := range limitByType {
+		if strings.TrimSpace(dsType) == "" {
+			return nil, errors.New("every row limit needs a data source type")
+		}
+		if limit <= 0 {
+			return nil, fmt.Errorf("row limit for data source type %q must be positive", dsType)
+		}
+	}
+	return limitByType, nil
+}
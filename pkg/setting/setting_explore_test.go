@@ -0,0 +1,118 @@
+package setting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/ini.v1"
+)
+
+func TestReadExploreSettings(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		conf     map[string]string
+		expected ExploreSettings
+	}{
+		{
+			desc:     "empty section keeps frontend defaults",
+			conf:     map[string]string{},
+			expected: ExploreSettings{},
+		},
+		{
+			desc: "log level colors",
+			conf: map[string]string{"log_level_colors": `{"Notice":"blue","audit":"#8f3bb8"}`},
+			expected: ExploreSettings{LogLevelColors: map[string]string{
+				"notice": "blue",
+				"audit":  "#8f3bb8",
+			}},
+		},
+		{
+			desc:     "invalid log level colors json is ignored",
+			conf:     map[string]string{"log_level_colors": `{"notice":`},
+			expected: ExploreSettings{},
+		},
+		{
+			desc:     "log level colors without a color are ignored",
+			conf:     map[string]string{"log_level_colors": `{"notice":""}`},
+			expected: ExploreSettings{},
+		},
+		{
+			desc: "visualization by data source type",
+			conf: map[string]string{"default_viz_by_datasource_type": `{"loki":"logs","prometheus":"graph"}`},
+			expected: ExploreSettings{DefaultVizByDatasourceType: map[string]string{
+				"loki":       "logs",
+				"prometheus": "graph",
+			}},
+		},
+		{
+			desc:     "unknown visualization is ignored",
+			conf:     map[string]string{"default_viz_by_datasource_type": `{"loki":"pie"}`},
+			expected: ExploreSettings{},
+		},
+		{
+			desc: "row limit by data source type",
+			conf: map[string]string{"row_limit_by_datasource_type": `{"loki":500,"elasticsearch":1000}`},
+			expected: ExploreSettings{RowLimitByDatasourceType: map[string]int{
+				"loki":          500,
+				"elasticsearch": 1000,
+			}},
+		},
+		{
+			desc:     "row limits that aren't positive are ignored",
+			conf:     map[string]string{"row_limit_by_datasource_type": `{"loki":0}`},
+			expected: ExploreSettings{},
+		},
+		{
+			desc:     "invalid row limit json is ignored",
+			conf:     map[string]string{"row_limit_by_datasource_type": `{"loki":"many"}`},
+			expected: ExploreSettings{},
+		},
+		{
+			desc:     "max session query history",
+			conf:     map[string]string{"max_session_query_history": "20"},
+			expected: ExploreSettings{MaxSessionQueryHistory: 20},
+		},
+		{
+			desc:     "negative max session query history uses the default",
+			conf:     map[string]string{"max_session_query_history": "-5"},
+			expected: ExploreSettings{},
+		},
+		{
+			desc:     "range select action",
+			conf:     map[string]string{"default_range_select_action": "copy"},
+			expected: ExploreSettings{DefaultRangeSelectAction: "copy"},
+		},
+		{
+			desc:     "unknown range select action is ignored",
+			conf:     map[string]string{"default_range_select_action": "share"},
+			expected: ExploreSettings{},
+		},
+		{
+			desc:     "supplementary queries turned off",
+			conf:     map[string]string{"default_supplementary_queries": "false"},
+			expected: ExploreSettings{DisableSupplementaryQueries: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			f := ini.Empty()
+			sec, err := f.NewSection("explore")
+			require.NoError(t, err)
+			for k, v := range tc.conf {
+				_, err := sec.NewKey(k, v)
+				require.NoError(t, err)
+			}
+
+			cfg := NewCfg()
+			cfg.Raw = f
+			cfg.readExploreSettings()
+
+			if tc.expected.LogLevelColors == nil {
+				tc.expected.LogLevelColors = map[string]string{}
+			}
+			assert.Equal(t, tc.expected, cfg.Explore)
+		})
+	}
+}
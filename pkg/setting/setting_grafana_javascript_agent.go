@@ -9,10 +9,17 @@ type GrafanaJavascriptAgent struct {
 	ConsoleInstrumentalizationEnabled   bool   `json:"consoleInstrumentalizationEnabled"`
 	WebVitalsInstrumentalizationEnabled bool   `json:"webVitalsInstrumentalizationEnabled"`
 	ApiKey                              string `json:"apiKey"`
+	// EnabledForRoles are the org roles the agent is enabled for, empty means all roles
+	EnabledForRoles []string `json:"-"`
 }
 
-func (cfg *Cfg) readGrafanaJavascriptAgentConfig() {
+func (cfg *Cfg) readGrafanaJavascriptAgentConfig() error {
 	raw := cfg.Raw.Section("log.frontend")
+	enabledForRoles, err := readEnabledForRoles(raw, "enabled_for_roles")
+	if err != nil {
+		return err
+	}
+
 	cfg.GrafanaJavascriptAgent = GrafanaJavascriptAgent{
 		Enabled:                             raw.Key("enabled").MustBool(true),
 		CustomEndpoint:                      raw.Key("custom_endpoint").MustString("/log-grafana-javascript-agent"),
@@ -22,5 +29,7 @@ func (cfg *Cfg) readGrafanaJavascriptAgentConfig() {
 		ConsoleInstrumentalizationEnabled:   raw.Key("instrumentations_console_enabled").MustBool(true),
 		WebVitalsInstrumentalizationEnabled: raw.Key("instrumentations_webvitals_enabled").MustBool(true),
 		ApiKey:                              raw.Key("api_key").String(),
+		EnabledForRoles:                     enabledForRoles,
 	}
+	return nil
 }
@@ -0,0 +1,24 @@
+package setting
+
+import (
+	"github.com/grafana/grafana/pkg/util"
+)
+
+// NavigationSettings contains the instance wide navigation customization,
+// organizations can override it in their preferences.
+type NavigationSettings struct {
+	// HiddenSections are the ids of the navigation sections hidden from users.
+	HiddenSections []string
+	// PinnedItems are the ids of the app plugins pinned to the navigation.
+	PinnedItems []string
+	// MaxBreadcrumbDepth is the number of breadcrumbs shown before the middle ones are
+	// collapsed into an ellipsis, 0 means no limit.
+	MaxBreadcrumbDepth int
+}
+
+func (cfg *Cfg) readNavigationSettings() {
+	navigation := cfg.Raw.Section("navigation")
+	cfg.Navigation.HiddenSections = util.SplitString(navigation.Key("hidden_sections").String())
+	cfg.Navigation.PinnedItems = util.SplitString(navigation.Key("pinned_items").String())
+	cfg.Navigation.MaxBreadcrumbDepth = readLimit(navigation, "max_breadcrumb_depth")
+}
@@ -0,0 +1,156 @@
+package setting
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/grafana/grafana-plugin-sdk-go/backend/gtime"
+)
+
+// reduceCalcs are the calculations the frontend can reduce a field to, see ReducerID in @grafana/data.
+var reduceCalcs = []string{
+	"sum", "max", "min", "logmin", "mean", "variance", "stdDev", "last", "first", "count", "range", "diff",
+	"diffperc", "delta", "step", "firstNotNull", "lastNotNull", "changeCount", "distinctCount", "allIsZero",
+	"allIsNull", "allValues", "uniqueValues",
+}
+
+// specialValueMatches are the values a special value mapping can match, see SpecialValueMatch in @grafana/data.
+var specialValueMatches = []string{"true", "false", "null", "nan", "null+nan", "empty"}
+
+// PanelsSettings contains the defaults the frontend applies to newly created panels.
+// Zero values keep the built-in frontend defaults.
+type PanelsSettings struct {
+	// DefaultLogsSortOrder is the sort order of new logs panels, one of "asc" or "desc".
+	DefaultLogsSortOrder string
+	// DefaultThresholdSteps are the threshold steps of new panels, each with a "color" and a "value".
+	DefaultThresholdSteps []map[string]any
+	// MaxConcurrentTransformations limits how many panel data transformations run at the same time.
+	MaxConcurrentTransformations int
+	// DefaultTextPanelContent is the content of new text panels.
+	DefaultTextPanelContent string
+	// MaxPanelLinks is the maximum number of links per panel, 0 means unlimited.
+	MaxPanelLinks int
+	// DefaultReduceCalc is the calculation new stat, gauge, bar gauge and pie chart panels reduce values with, e.g. "mean".
+	DefaultReduceCalc string
+	// DefaultMinInterval is the minimum query interval of new panels, e.g. "10s".
+	DefaultMinInterval string
+	// DefaultBorderStyle is the border style of new panels, one of "none", "solid" or "shadow".
+	DefaultBorderStyle string
+	// DefaultFillOpacity is the fill opacity of new time series panels, from 0 to 100, 0 keeps the built-in default.
+	DefaultFillOpacity int
+	// MaxInlineSVGBytes is the maximum size of SVGs panels render inline, 0 means unlimited.
+	MaxInlineSVGBytes int
+	// DefaultShowDescription makes new panels show their description below the title instead of behind an info icon.
+	DefaultShowDescription bool
+	// DefaultTimeShift is the time shift of new panels, e.g. "1w" to compare with the previous week.
+	DefaultTimeShift string
+	// DefaultRepeatScope is the default scope of new repeats of panels inside a row, one of "panel" or "row".
+	DefaultRepeatScope string
+	// DefaultSpecialValueMappings are the special value mappings of new panels, each with a "type" of "special"
+	// and "options" holding the "match" and its "result".
+	DefaultSpecialValueMappings []map[string]any
+}
+
+func (cfg *Cfg) readPanelsSettings() {
+	panels := cfg.Raw.Section("panels")
+	cfg.Panels.DefaultLogsSortOrder = panels.Key("default_logs_sort_order").In("", []string{"asc", "desc"})
+
+	cfg.Panels.MaxConcurrentTransformations = readLimit(panels, "max_concurrent_transformations")
+	cfg.Panels.DefaultTextPanelContent = panels.Key("default_text_panel_content").String()
+	cfg.Panels.MaxPanelLinks = readLimit(panels, "max_panel_links")
+	cfg.Panels.DefaultReduceCalc = panels.Key("default_reduce_calc").In("", reduceCalcs)
+
+	cfg.Panels.DefaultMinInterval = valueAsString(panels, "default_min_interval", "")
+	if cfg.Panels.DefaultMinInterval != "" {
+		if _, err := gtime.ParseDuration(cfg.Panels.DefaultMinInterval); err != nil {
+			cfg.Logger.Warn("default_min_interval is not a valid interval, using the default", "value", cfg.Panels.DefaultMinInterval, "error", err)
+			cfg.Panels.DefaultMinInterval = ""
+		}
+	}
+
+	cfg.Panels.DefaultTimeShift = valueAsString(panels, "default_time_shift", "")
+	if cfg.Panels.DefaultTimeShift != "" {
+		if _, err := gtime.ParseDuration(cfg.Panels.DefaultTimeShift); err != nil {
+			cfg.Logger.Warn("default_time_shift is not a valid interval, using the default", "value", cfg.Panels.DefaultTimeShift, "error", err)
+			cfg.Panels.DefaultTimeShift = ""
+		}
+	}
+
+	cfg.Panels.DefaultBorderStyle = panels.Key("default_border_style").In("", []string{"none", "solid", "shadow"})
+	cfg.Panels.DefaultRepeatScope = panels.Key("default_repeat_scope").In("", []string{"panel", "row"})
+
+	// 0 is the built-in fill opacity of time series panels, negative values keep it as well
+	cfg.Panels.DefaultFillOpacity = panels.Key("default_fill_opacity").MustInt(0)
+	if cfg.Panels.DefaultFillOpacity > 100 {
+		cfg.Logger.Warn("default_fill_opacity can't be above 100, using the default", "value", cfg.Panels.DefaultFillOpacity)
+		cfg.Panels.DefaultFillOpacity = 0
+	} else if cfg.Panels.DefaultFillOpacity < 0 {
+		cfg.Panels.DefaultFillOpacity = 0
+	}
+
+	cfg.Panels.MaxInlineSVGBytes = readLimit(panels, "max_inline_svg_bytes")
+	cfg.Panels.DefaultShowDescription = panels.Key("default_show_description").MustBool(false)
+
+	// an empty list rather than nil, so that the frontend gets [] instead of null
+	cfg.Panels.DefaultThresholdSteps = []map[string]any{}
+	if stepsJSON := valueAsString(panels, "default_threshold_steps", ""); stepsJSON != "" {
+		steps, err := parseThresholdSteps(stepsJSON)
+		if err != nil {
+			cfg.Logger.Error("Error reading json from default_threshold_steps", "error", err)
+		} else if steps != nil {
+			cfg.Panels.DefaultThresholdSteps = steps
+		}
+	}
+
+	cfg.Panels.DefaultSpecialValueMappings = []map[string]any{}
+	if mappingsJSON := valueAsString(panels, "default_special_value_mappings", ""); mappingsJSON != "" {
+		mappings, err := parseSpecialValueMappings(mappingsJSON)
+		if err != nil {
+			cfg.Logger.Error("Error reading json from default_special_value_mappings", "error", err)
+		} else if mappings != nil {
+			cfg.Panels.DefaultSpecialValueMappings = mappings
+		}
+	}
+}
+
+func parseThresholdSteps(stepsJSON string) ([]map[string]any, error) {
+	var steps []map[string]any
+	if err := json.Unmarshal([]byte(stepsJSON), &steps); err != nil {
+		return nil, err
+	}
+	for _, step := range steps {
+		if color, ok := step["color"].(string); !ok || color == "" {
+			return nil, errors.New("every threshold step needs a color")
+		}
+		// the base step has a null value
+		if _, ok := step["value"].(float64); !ok && step["value"] != nil {
+			return nil, errors.New("threshold step values must be numbers")
+		}
+	}
+	return steps, nil
+}
+
+func parseSpecialValueMappings(mappingsJSON string) ([]map[string]any, error) {
+	var mappings []map[string]any
+	if err := json.Unmarshal([]byte(mappingsJSON), &mappings); err != nil {
+		return nil, err
+	}
+	for _, mapping := range mappings {
+		if mapping["type"] != "special" {
+			return nil, errors.New("value mappings must have the special type")
+		}
+		options, ok := mapping["options"].(map[string]any)
+		if !ok {
+			return nil, errors.New("special value mappings need options")
+		}
+		if match, _ := options["match"].(string); !slices.Contains(specialValueMatches, match) {
+			return nil, fmt.Errorf("unknown special value match %q", options["match"])
+		}
+		if _, ok := options["result"].(map[string]any); !ok {
+			return nil, errors.New("special value mappings need a result")
+		}
+	}
+	return mappings, nil
+}
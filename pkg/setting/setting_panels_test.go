@@ -0,0 +1,198 @@
+package setting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/ini.v1"
+)
+
+func TestReadPanelsSettings(t *testing.T) {
+	testCases := []struct {
+		desc     string
+		conf     map[string]string
+		expected PanelsSettings
+	}{
+		{
+			desc:     "empty section keeps frontend defaults",
+			conf:     map[string]string{},
+			expected: PanelsSettings{},
+		},
+		{
+			desc:     "ascending logs sort order",
+			conf:     map[string]string{"default_logs_sort_order": "asc"},
+			expected: PanelsSettings{DefaultLogsSortOrder: "asc"},
+		},
+		{
+			desc:     "unknown logs sort order is ignored",
+			conf:     map[string]string{"default_logs_sort_order": "oldest"},
+			expected: PanelsSettings{},
+		},
+		{
+			desc: "threshold steps",
+			conf: map[string]string{"default_threshold_steps": `[{"color":"green","value":null},{"color":"yellow","value":80},{"color":"red","value":95}]`},
+			expected: PanelsSettings{DefaultThresholdSteps: []map[string]any{
+				{"color": "green", "value": nil},
+				{"color": "yellow", "value": float64(80)},
+				{"color": "red", "value": float64(95)},
+			}},
+		},
+		{
+			desc:     "max concurrent transformations",
+			conf:     map[string]string{"max_concurrent_transformations": "4"},
+			expected: PanelsSettings{MaxConcurrentTransformations: 4},
+		},
+		{
+			desc:     "negative max concurrent transformations is unlimited",
+			conf:     map[string]string{"max_concurrent_transformations": "-1"},
+			expected: PanelsSettings{},
+		},
+		{
+			desc:     "text panel content",
+			conf:     map[string]string{"default_text_panel_content": "# Team dashboard\n\nOwner: "},
+			expected: PanelsSettings{DefaultTextPanelContent: "# Team dashboard\n\nOwner: "},
+		},
+		{
+			desc:     "max panel links",
+			conf:     map[string]string{"max_panel_links": "5"},
+			expected: PanelsSettings{MaxPanelLinks: 5},
+		},
+		{
+			desc:     "negative max panel links means unlimited",
+			conf:     map[string]string{"max_panel_links": "-1"},
+			expected: PanelsSettings{},
+		},
+		{
+			desc:     "reduce calculation",
+			conf:     map[string]string{"default_reduce_calc": "mean"},
+			expected: PanelsSettings{DefaultReduceCalc: "mean"},
+		},
+		{
+			desc:     "unknown reduce calculation is ignored",
+			conf:     map[string]string{"default_reduce_calc": "average"},
+			expected: PanelsSettings{},
+		},
+		{
+			desc:     "min interval",
+			conf:     map[string]string{"default_min_interval": "5s"},
+			expected: PanelsSettings{DefaultMinInterval: "5s"},
+		},
+		{
+			desc:     "invalid min interval is ignored",
+			conf:     map[string]string{"default_min_interval": "fast"},
+			expected: PanelsSettings{},
+		},
+		{
+			desc:     "border style",
+			conf:     map[string]string{"default_border_style": "shadow"},
+			expected: PanelsSettings{DefaultBorderStyle: "shadow"},
+		},
+		{
+			desc:     "unknown border style is ignored",
+			conf:     map[string]string{"default_border_style": "dashed"},
+			expected: PanelsSettings{},
+		},
+		{
+			desc:     "fill opacity",
+			conf:     map[string]string{"default_fill_opacity": "25"},
+			expected: PanelsSettings{DefaultFillOpacity: 25},
+		},
+		{
+			desc:     "negative fill opacity keeps the built-in default",
+			conf:     map[string]string{"default_fill_opacity": "-1"},
+			expected: PanelsSettings{},
+		},
+		{
+			desc:     "fill opacity above 100 is ignored",
+			conf:     map[string]string{"default_fill_opacity": "150"},
+			expected: PanelsSettings{},
+		},
+		{
+			desc:     "max inline svg bytes",
+			conf:     map[string]string{"max_inline_svg_bytes": "1048576"},
+			expected: PanelsSettings{MaxInlineSVGBytes: 1048576},
+		},
+		{
+			desc:     "show description",
+			conf:     map[string]string{"default_show_description": "true"},
+			expected: PanelsSettings{DefaultShowDescription: true},
+		},
+		{
+			desc:     "time shift",
+			conf:     map[string]string{"default_time_shift": "1w"},
+			expected: PanelsSettings{DefaultTimeShift: "1w"},
+		},
+		{
+			desc:     "invalid time shift is ignored",
+			conf:     map[string]string{"default_time_shift": "last week"},
+			expected: PanelsSettings{},
+		},
+		{
+			desc:     "repeat scope",
+			conf:     map[string]string{"default_repeat_scope": "row"},
+			expected: PanelsSettings{DefaultRepeatScope: "row"},
+		},
+		{
+			desc:     "unknown repeat scope is ignored",
+			conf:     map[string]string{"default_repeat_scope": "dashboard"},
+			expected: PanelsSettings{},
+		},
+		{
+			desc:     "invalid threshold steps json is ignored",
+			conf:     map[string]string{"default_threshold_steps": `[{"color":"green"`},
+			expected: PanelsSettings{},
+		},
+		{
+			desc:     "threshold steps without a color are ignored",
+			conf:     map[string]string{"default_threshold_steps": `[{"value":80}]`},
+			expected: PanelsSettings{},
+		},
+		{
+			desc: "special value mappings",
+			conf: map[string]string{"default_special_value_mappings": `[{"type":"special","options":{"match":"nan","result":{"text":"NaN"}}}]`},
+			expected: PanelsSettings{DefaultSpecialValueMappings: []map[string]any{
+				{"type": "special", "options": map[string]any{"match": "nan", "result": map[string]any{"text": "NaN"}}},
+			}},
+		},
+		{
+			desc:     "invalid special value mappings json is ignored",
+			conf:     map[string]string{"default_special_value_mappings": `[{"type":"special"`},
+			expected: PanelsSettings{},
+		},
+		{
+			desc:     "special value mappings with an unknown match are ignored",
+			conf:     map[string]string{"default_special_value_mappings": `[{"type":"special","options":{"match":"inf","result":{"text":"Inf"}}}]`},
+			expected: PanelsSettings{},
+		},
+		{
+			desc:     "value mappings of other types are ignored",
+			conf:     map[string]string{"default_special_value_mappings": `[{"type":"value","options":{"1":{"text":"one"}}}]`},
+			expected: PanelsSettings{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.desc, func(t *testing.T) {
+			f := ini.Empty()
+			sec, err := f.NewSection("panels")
+			require.NoError(t, err)
+			for k, v := range tc.conf {
+				_, err := sec.NewKey(k, v)
+				require.NoError(t, err)
+			}
+
+			cfg := NewCfg()
+			cfg.Raw = f
+			cfg.readPanelsSettings()
+
+			if tc.expected.DefaultThresholdSteps == nil {
+				tc.expected.DefaultThresholdSteps = []map[string]any{}
+			}
+			if tc.expected.DefaultSpecialValueMappings == nil {
+				tc.expected.DefaultSpecialValueMappings = []map[string]any{}
+			}
+			assert.Equal(t, tc.expected, cfg.Panels)
+		})
+	}
+}
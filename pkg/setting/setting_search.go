@@ -10,6 +10,10 @@ type SearchSettings struct {
 	FullReindexInterval       time.Duration
 	IndexUpdateInterval       time.Duration
 	DashboardLoadingBatchSize int
+	// MaxResults caps the number of results returned by a single search request, 0 keeps the default.
+	MaxResults int
+	// MaxQueryLength is the maximum number of characters of the search box query, 0 means unlimited.
+	MaxQueryLength int
 }
 
 func readSearchSettings(iniFile *ini.File) SearchSettings {
@@ -19,5 +23,7 @@ func readSearchSettings(iniFile *ini.File) SearchSettings {
 	s.DashboardLoadingBatchSize = searchSection.Key("dashboard_loading_batch_size").MustInt(200)
 	s.FullReindexInterval = searchSection.Key("full_reindex_interval").MustDuration(5 * time.Minute)
 	s.IndexUpdateInterval = searchSection.Key("index_update_interval").MustDuration(10 * time.Second)
+	s.MaxResults = readLimit(searchSection, "max_results")
+	s.MaxQueryLength = readLimit(searchSection, "max_query_length")
 	return s
 }
@@ -0,0 +1,27 @@
+package setting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/ini.v1"
+)
+
+func TestReadSearchSettings(t *testing.T) {
+	t.Run("max results", func(t *testing.T) {
+		iniFile := ini.Empty()
+		_, err := iniFile.Section("search").NewKey("max_results", "500")
+		require.NoError(t, err)
+
+		assert.Equal(t, 500, readSearchSettings(iniFile).MaxResults)
+	})
+
+	t.Run("negative max results keeps the default", func(t *testing.T) {
+		iniFile := ini.Empty()
+		_, err := iniFile.Section("search").NewKey("max_results", "-1")
+		require.NoError(t, err)
+
+		assert.Equal(t, 0, readSearchSettings(iniFile).MaxResults)
+	})
+}
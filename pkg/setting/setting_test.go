@@ -325,6 +325,29 @@ func TestParseAppURLAndSubURL(t *testing.T) {
 	}
 }
 
+func TestAuthIdleLogoutSettings(t *testing.T) {
+	f := ini.Empty()
+	cfg := NewCfg()
+	_, err := f.NewSection("auth")
+	require.NoError(t, err)
+	err = readAuthSettings(f, cfg)
+	require.NoError(t, err)
+	require.False(t, cfg.AutoLogoutOnIdle)
+	require.Equal(t, 15*time.Minute, cfg.IdleTimeout)
+
+	f = ini.Empty()
+	sec, err := f.NewSection("auth")
+	require.NoError(t, err)
+	_, err = sec.NewKey("auto_logout_on_idle", "true")
+	require.NoError(t, err)
+	_, err = sec.NewKey("idle_timeout", "1h")
+	require.NoError(t, err)
+	err = readAuthSettings(f, cfg)
+	require.NoError(t, err)
+	require.True(t, cfg.AutoLogoutOnIdle)
+	require.Equal(t, time.Hour, cfg.IdleTimeout)
+}
+
 func TestAuthDurationSettings(t *testing.T) {
 	const maxInactiveDaysTest = 240 * time.Hour
 
@@ -884,3 +907,23 @@ func TestHandleAWSSettings(t *testing.T) {
 		assert.Equal(t, 400, cfg.AWSListMetricsPageLimit)
 	})
 }
+
+func TestReadEnabledForRoles(t *testing.T) {
+	cfg := NewCfg()
+	section, err := cfg.Raw.NewSection("analytics")
+	require.NoError(t, err)
+
+	roles, err := readEnabledForRoles(section, "application_insights_enabled_for_roles")
+	require.NoError(t, err)
+	assert.Empty(t, roles)
+
+	_, err = section.NewKey("application_insights_enabled_for_roles", "Admin, Editor")
+	require.NoError(t, err)
+	roles, err = readEnabledForRoles(section, "application_insights_enabled_for_roles")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Admin", "Editor"}, roles)
+
+	section.Key("application_insights_enabled_for_roles").SetValue("Admin, Editor,Superuser")
+	_, err = readEnabledForRoles(section, "application_insights_enabled_for_roles")
+	require.Error(t, err)
+}
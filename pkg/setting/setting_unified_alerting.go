@@ -98,6 +98,10 @@ type UnifiedAlertingSettings struct {
 	RemoteAlertmanager            RemoteAlertmanagerSettings
 	// MaxStateSaveConcurrency controls the number of goroutines (per rule) that can save alert state in parallel.
 	MaxStateSaveConcurrency int
+	// DefaultContactPoint is the name of the contact point the rule editor selects for new alert rules.
+	DefaultContactPoint string
+	// MaxRulesPerGroup is the number of rules at which the rule group editor warns. 0 means unlimited.
+	MaxRulesPerGroup int
 }
 
 // RemoteAlertmanagerSettings contains the configuration needed
@@ -398,6 +402,8 @@ func (cfg *Cfg) ReadUnifiedAlertingSettings(iniFile *ini.File) error {
 	uaCfg.StateHistory = uaCfgStateHistory
 
 	uaCfg.MaxStateSaveConcurrency = ua.Key("max_state_save_concurrency").MustInt(1)
+	uaCfg.DefaultContactPoint = strings.TrimSpace(ua.Key("default_contact_point").MustString(""))
+	uaCfg.MaxRulesPerGroup = readLimit(ua, "max_rules_per_group")
 
 	cfg.UnifiedAlerting = uaCfg
 	return nil
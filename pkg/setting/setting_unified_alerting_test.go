@@ -25,6 +25,7 @@ func TestCfg_ReadUnifiedAlertingSettings(t *testing.T) {
 		require.Len(t, cfg.UnifiedAlerting.HAPeers, 0)
 		require.Equal(t, 200*time.Millisecond, cfg.UnifiedAlerting.HAGossipInterval)
 		require.Equal(t, time.Minute, cfg.UnifiedAlerting.HAPushPullInterval)
+		require.Empty(t, cfg.UnifiedAlerting.DefaultContactPoint)
 	}
 
 	// With peers set, it correctly parses them.
@@ -40,6 +41,33 @@ func TestCfg_ReadUnifiedAlertingSettings(t *testing.T) {
 		require.ElementsMatch(t, []string{"hostname1:9090", "hostname2:9090", "hostname3:9090"}, cfg.UnifiedAlerting.HAPeers)
 	}
 
+	t.Run("should read 'default_contact_point'", func(t *testing.T) {
+		s, err := cfg.Raw.NewSection("unified_alerting")
+		require.NoError(t, err)
+		_, err = s.NewKey("default_contact_point", " team-oncall ")
+		require.NoError(t, err)
+		t.Cleanup(func() { s.DeleteKey("default_contact_point") })
+
+		require.NoError(t, cfg.ReadUnifiedAlertingSettings(cfg.Raw))
+		require.Equal(t, "team-oncall", cfg.UnifiedAlerting.DefaultContactPoint)
+	})
+
+	t.Run("should read 'max_rules_per_group'", func(t *testing.T) {
+		s, err := cfg.Raw.NewSection("unified_alerting")
+		require.NoError(t, err)
+		_, err = s.NewKey("max_rules_per_group", "20")
+		require.NoError(t, err)
+		t.Cleanup(func() { s.DeleteKey("max_rules_per_group") })
+
+		require.NoError(t, cfg.ReadUnifiedAlertingSettings(cfg.Raw))
+		require.Equal(t, 20, cfg.UnifiedAlerting.MaxRulesPerGroup)
+
+		_, err = s.NewKey("max_rules_per_group", "-1")
+		require.NoError(t, err)
+		require.NoError(t, cfg.ReadUnifiedAlertingSettings(cfg.Raw))
+		require.Equal(t, 0, cfg.UnifiedAlerting.MaxRulesPerGroup)
+	})
+
 	t.Run("should read 'scheduler_tick_interval'", func(t *testing.T) {
 		tmp := cfg.IsFeatureToggleEnabled
 		t.Cleanup(func() {
@@ -0,0 +1,91 @@
+package setting
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/grafana/grafana/pkg/util"
+)
+
+const (
+	// MaxFooterLinks is the maximum number of custom footer links rendered on every page.
+	MaxFooterLinks = 10
+	// DefaultFooterLinkIcon is used for footer links that don't set a known icon.
+	DefaultFooterLinkIcon = "external-link-alt"
+)
+
+// footerLinkIcons are the icon names that can be used for custom footer links.
+var footerLinkIcons = map[string]bool{
+	"book":              true,
+	"book-open":         true,
+	"bookmark":          true,
+	"building":          true,
+	"comment-alt":       true,
+	"comments-alt":      true,
+	"document-info":     true,
+	"envelope":          true,
+	"external-link-alt": true,
+	"github":            true,
+	"heart":             true,
+	"info-circle":       true,
+	"link":              true,
+	"question-circle":   true,
+	"shield":            true,
+	"slack":             true,
+	"star":              true,
+	"users-alt":         true,
+}
+
+// ValidateFooterLinkURL returns an error unless the URL is an absolute http(s) or mailto URL,
+// which prevents custom footer links from injecting javascript: or data: URLs into the page.
+func ValidateFooterLinkURL(rawURL string) error {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return fmt.Errorf("invalid url %q: %w", rawURL, err)
+	}
+
+	switch strings.ToLower(u.Scheme) {
+	case "http", "https":
+		if u.Host == "" {
+			return fmt.Errorf("url %q has no host", rawURL)
+		}
+		return nil
+	case "mailto":
+		if u.Opaque == "" {
+			return fmt.Errorf("url %q has no address", rawURL)
+		}
+		return nil
+	case "":
+		return fmt.Errorf("url %q is not absolute", rawURL)
+	default:
+		return fmt.Errorf("url %q uses unsupported scheme %q", rawURL, u.Scheme)
+	}
+}
+
+// IsValidFooterLinkIcon reports whether the icon can be used for a custom footer link.
+func IsValidFooterLinkIcon(icon string) bool {
+	return footerLinkIcons[icon]
+}
+
+// validateWhiteLabelingFooterLinks reports misconfigured custom footer links at startup,
+// the same links are dropped or fixed when the frontend settings are built.
+func (cfg *Cfg) validateWhiteLabelingFooterLinks() {
+	section := cfg.SectionWithEnvOverrides("white_labeling")
+	ids := util.SplitString(section.Key("footer_links").String())
+
+	if len(ids) > MaxFooterLinks {
+		cfg.Logger.Warn("Too many footer links configured, only the first ones are shown", "max", MaxFooterLinks, "configured", len(ids))
+	}
+
+	for _, id := range ids {
+		prefix := "footer_links_" + id
+		if err := ValidateFooterLinkURL(section.Key(prefix + "_url").String()); err != nil {
+			cfg.Logger.Error("Footer link has an invalid url and is not shown", "link", id, "error", err)
+		}
+
+		if icon := section.Key(prefix + "_icon").String(); icon != "" && !IsValidFooterLinkIcon(icon) {
+			cfg.Logger.Warn("Footer link has an unknown icon, falling back to the default icon", "link", id, "icon", icon, "default", DefaultFooterLinkIcon)
+		}
+	}
+}
@@ -0,0 +1,37 @@
+package setting
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateFooterLinkURL(t *testing.T) {
+	tests := []struct {
+		url   string
+		valid bool
+	}{
+		{url: "https://support.example.com/help", valid: true},
+		{url: "http://support.example.com", valid: true},
+		{url: "mailto:support@example.com", valid: true},
+		{url: "javascript:alert(document.cookie)", valid: false},
+		{url: "JavaScript:alert(1)", valid: false},
+		{url: "data:text/html;base64,PHNjcmlwdD4=", valid: false},
+		{url: "/relative/path", valid: false},
+		{url: "//support.example.com", valid: false},
+		{url: "https://", valid: false},
+		{url: "mailto:", valid: false},
+		{url: "", valid: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			err := ValidateFooterLinkURL(tt.url)
+			if tt.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
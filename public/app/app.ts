@@ -67,6 +67,7 @@ import { GA4EchoBackend } from './core/services/echo/backends/analytics/GA4Backe
 import { GAEchoBackend } from './core/services/echo/backends/analytics/GABackend';
 import { RudderstackBackend } from './core/services/echo/backends/analytics/RudderstackBackend';
 import { GrafanaJavascriptAgentBackend } from './core/services/echo/backends/grafana-javascript-agent/GrafanaJavascriptAgentBackend';
+import { initIdleLogout } from './core/services/idleLogout';
 import { KeybindingSrv } from './core/services/keybindingSrv';
 import { startMeasure, stopMeasure } from './core/utils/metrics';
 import { initDevFeatures } from './dev';
@@ -81,6 +82,7 @@ import { getCoreExtensionConfigurations } from './features/plugins/extensions/ge
 import { getPluginExtensions } from './features/plugins/extensions/getPluginExtensions';
 import { importPanelPlugin, syncGetPanelPlugin } from './features/plugins/importPanelPlugin';
 import { preloadPlugins } from './features/plugins/pluginPreloader';
+import { redirectIfMustChangePassword } from './features/profile/mustChangePassword';
 import { QueryRunner } from './features/query/state/QueryRunner';
 import { runRequest } from './features/query/state/runRequest';
 import { initWindowRuntime } from './features/runtime/init';
@@ -186,6 +188,8 @@ export class GrafanaApp {
       // intercept anchor clicks and forward it to custom history instead of relying on browser's history
       document.addEventListener('click', interceptLinkClicks);
 
+      redirectIfMustChangePassword();
+
       // Init DataSourceSrv
       const dataSourceSrv = new DatasourceSrv();
       dataSourceSrv.init(config.datasources, config.defaultDatasource);
@@ -196,6 +200,8 @@ export class GrafanaApp {
       const modalManager = new ModalManager();
       modalManager.init();
 
+      initIdleLogout();
+
       // Preload selected app plugins
       const preloadResults = await preloadPlugins(config.apps);
 
@@ -337,6 +343,7 @@ function initEchoSrv() {
       new ApplicationInsightsBackend({
         connectionString: config.applicationInsightsConnectionString,
         endpointUrl: config.applicationInsightsEndpointUrl,
+        samplingPercentage: config.applicationInsightsSamplingPercentage,
       })
     );
   }
@@ -0,0 +1,46 @@
+import { render, screen } from '@testing-library/react';
+import userEvent from '@testing-library/user-event';
+import React from 'react';
+
+import config from 'app/core/config';
+
+import { DegradedModeBanner } from './DegradedModeBanner';
+
+describe('DegradedModeBanner', () => {
+  const originalBuildInfo = config.buildInfo;
+
+  afterEach(() => {
+    config.buildInfo = originalBuildInfo;
+  });
+
+  it('should not render when no subsystem is degraded', () => {
+    config.buildInfo = { ...originalBuildInfo, degradedMode: false, degradedReasons: [] };
+
+    const { container } = render(<DegradedModeBanner />);
+
+    expect(container).toBeEmptyDOMElement();
+  });
+
+  it('should list the degraded subsystems', () => {
+    config.buildInfo = {
+      ...originalBuildInfo,
+      degradedMode: true,
+      degradedReasons: ['database is unreachable', 'alert rule evaluation is paused'],
+    };
+
+    render(<DegradedModeBanner />);
+
+    expect(screen.getByRole('status')).toHaveTextContent(
+      'Grafana is running with reduced functionality: database is unreachable, alert rule evaluation is paused'
+    );
+  });
+
+  it('should hide when dismissed', async () => {
+    config.buildInfo = { ...originalBuildInfo, degradedMode: true, degradedReasons: ['database is unreachable'] };
+
+    render(<DegradedModeBanner />);
+    await userEvent.click(screen.getByRole('button', { name: 'Dismiss' }));
+
+    expect(screen.queryByRole('status')).not.toBeInTheDocument();
+  });
+});
@@ -0,0 +1,45 @@
+import { css } from '@emotion/css';
+import React, { useState } from 'react';
+
+import { GrafanaTheme2 } from '@grafana/data';
+import { Icon, IconButton, useStyles2 } from '@grafana/ui';
+import config from 'app/core/config';
+
+/**
+ * Shows a strip across the top of the page while subsystems of the server are degraded,
+ * so that users know why parts of Grafana don't work as expected.
+ */
+export function DegradedModeBanner() {
+  const styles = useStyles2(getStyles);
+  const [dismissed, setDismissed] = useState(false);
+  const { degradedMode, degradedReasons = [] } = config.buildInfo;
+
+  if (!degradedMode || dismissed) {
+    return null;
+  }
+
+  return (
+    <div className={styles.banner} role="status">
+      <Icon name="exclamation-triangle" />
+      <span className={styles.text}>
+        Grafana is running with reduced functionality
+        {degradedReasons.length > 0 && `: ${degradedReasons.join(', ')}`}
+      </span>
+      <IconButton name="times" tooltip="Dismiss" onClick={() => setDismissed(true)} />
+    </div>
+  );
+}
+
+const getStyles = (theme: GrafanaTheme2) => ({
+  banner: css({
+    display: 'flex',
+    alignItems: 'center',
+    gap: theme.spacing(1),
+    padding: theme.spacing(0.5, 2),
+    background: theme.colors.warning.main,
+    color: theme.colors.warning.contrastText,
+  }),
+  text: css({
+    flexGrow: 1,
+  }),
+});
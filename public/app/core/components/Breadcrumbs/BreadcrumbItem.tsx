@@ -52,6 +52,25 @@ export function BreadcrumbItem({ href, isCurrent, text, index, flexGrow }: Props
   );
 }
 
+interface EllipsisProps {
+  hidden: Breadcrumb[];
+}
+
+export function BreadcrumbEllipsis({ hidden }: EllipsisProps) {
+  const styles = useStyles2(getStyles);
+
+  return (
+    <li className={styles.breadcrumbWrapper} data-testid="breadcrumb-ellipsis">
+      <span className={styles.breadcrumb} title={hidden.map((breadcrumb) => breadcrumb.text).join(' / ')}>
+        &hellip;
+      </span>
+      <div className={styles.separator} aria-hidden={true}>
+        <Icon name="angle-right" />
+      </div>
+    </li>
+  );
+}
+
 const getStyles = (theme: GrafanaTheme2) => {
   return {
     breadcrumb: css({
@@ -1,6 +1,8 @@
 import { render, screen, within } from '@testing-library/react';
 import React from 'react';
 
+import { config } from '@grafana/runtime';
+
 import { Breadcrumbs } from './Breadcrumbs';
 import { Breadcrumb } from './types';
 
@@ -40,4 +42,32 @@ describe('Breadcrumbs', () => {
     expect(within(nav).getByText('Second')).toBeInTheDocument();
     expect(within(nav).getByText('Second')).toHaveAttribute('aria-current', 'page');
   });
+
+  describe('with a max breadcrumb depth', () => {
+    const originalMaxBreadcrumbDepth = config.maxBreadcrumbDepth;
+
+    afterEach(() => {
+      config.maxBreadcrumbDepth = originalMaxBreadcrumbDepth;
+    });
+
+    it('should collapse the breadcrumbs beyond the depth into an ellipsis', () => {
+      config.maxBreadcrumbDepth = 2;
+      render(<Breadcrumbs breadcrumbs={mockBreadcrumbs} />);
+      const nav = screen.getByRole('navigation');
+
+      expect(within(nav).getByRole('link', { name: 'Home' })).toBeInTheDocument();
+      expect(within(nav).queryByRole('link', { name: 'First' })).not.toBeInTheDocument();
+      expect(within(nav).getByText('Second')).toHaveAttribute('aria-current', 'page');
+      expect(screen.getByTestId('breadcrumb-ellipsis')).toBeInTheDocument();
+      expect(within(nav).getByTitle('First')).toBeInTheDocument();
+    });
+
+    it('should not collapse breadcrumbs within the depth', () => {
+      config.maxBreadcrumbDepth = 3;
+      render(<Breadcrumbs breadcrumbs={mockBreadcrumbs} />);
+
+      expect(within(screen.getByRole('navigation')).getAllByRole('link')).toHaveLength(2);
+      expect(screen.queryByTestId('breadcrumb-ellipsis')).not.toBeInTheDocument();
+    });
+  });
 });
@@ -2,10 +2,12 @@ import { css } from '@emotion/css';
 import React from 'react';
 
 import { GrafanaTheme2 } from '@grafana/data';
+import { config } from '@grafana/runtime';
 import { useStyles2 } from '@grafana/ui';
 
-import { BreadcrumbItem } from './BreadcrumbItem';
+import { BreadcrumbEllipsis, BreadcrumbItem } from './BreadcrumbItem';
 import { Breadcrumb } from './types';
+import { truncateBreadcrumbs } from './utils';
 
 export interface Props {
   breadcrumbs: Breadcrumb[];
@@ -14,19 +16,25 @@ export interface Props {
 
 export function Breadcrumbs({ breadcrumbs, className }: Props) {
   const styles = useStyles2(getStyles);
+  const { leading, hidden, trailing } = truncateBreadcrumbs(breadcrumbs, config.maxBreadcrumbDepth);
+  const visible = [...leading, ...trailing];
+
+  const renderItem = (breadcrumb: Breadcrumb, index: number) => (
+    <BreadcrumbItem
+      {...breadcrumb}
+      isCurrent={index === visible.length - 1}
+      key={index}
+      index={index}
+      flexGrow={getFlexGrow(index, visible.length)}
+    />
+  );
 
   return (
     <nav aria-label="Breadcrumbs" className={className}>
       <ol className={styles.breadcrumbs}>
-        {breadcrumbs.map((breadcrumb, index) => (
-          <BreadcrumbItem
-            {...breadcrumb}
-            isCurrent={index === breadcrumbs.length - 1}
-            key={index}
-            index={index}
-            flexGrow={getFlexGrow(index, breadcrumbs.length)}
-          />
-        ))}
+        {leading.map((breadcrumb, index) => renderItem(breadcrumb, index))}
+        {hidden.length > 0 && <BreadcrumbEllipsis hidden={hidden} />}
+        {trailing.map((breadcrumb, index) => renderItem(breadcrumb, leading.length + index))}
       </ol>
     </nav>
   );
@@ -1,6 +1,7 @@
 import { NavModelItem } from '@grafana/data';
 
-import { buildBreadcrumbs } from './utils';
+import { Breadcrumb } from './types';
+import { buildBreadcrumbs, truncateBreadcrumbs } from './utils';
 
 const mockHomeNav: NavModelItem = {
   text: 'Home',
@@ -142,4 +143,38 @@ describe('breadcrumb utils', () => {
       ]);
     });
   });
+
+  describe('truncateBreadcrumbs', () => {
+    const breadcrumbs: Breadcrumb[] = [
+      { text: 'Home', href: '/home' },
+      { text: 'First', href: '/first' },
+      { text: 'Second', href: '/second' },
+      { text: 'Third', href: '/third' },
+      { text: 'Fourth', href: '/fourth' },
+    ];
+
+    it('keeps every breadcrumb without a max depth', () => {
+      expect(truncateBreadcrumbs(breadcrumbs, 0)).toEqual({ leading: breadcrumbs, hidden: [], trailing: [] });
+    });
+
+    it('keeps every breadcrumb within the max depth', () => {
+      expect(truncateBreadcrumbs(breadcrumbs, 5)).toEqual({ leading: breadcrumbs, hidden: [], trailing: [] });
+    });
+
+    it('hides the middle breadcrumbs beyond the max depth', () => {
+      expect(truncateBreadcrumbs(breadcrumbs, 3)).toEqual({
+        leading: [breadcrumbs[0]],
+        hidden: [breadcrumbs[1], breadcrumbs[2]],
+        trailing: [breadcrumbs[3], breadcrumbs[4]],
+      });
+    });
+
+    it('only keeps the current page with a max depth of 1', () => {
+      expect(truncateBreadcrumbs(breadcrumbs, 1)).toEqual({
+        leading: [],
+        hidden: breadcrumbs.slice(0, 4),
+        trailing: [breadcrumbs[4]],
+      });
+    });
+  });
 });
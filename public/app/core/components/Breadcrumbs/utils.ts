@@ -53,3 +53,19 @@ export function buildBreadcrumbs(sectionNav: NavModelItem, pageNav?: NavModelIte
 
   return crumbs;
 }
+
+/**
+ * Splits the breadcrumbs around the ones hidden behind an ellipsis so no more than maxDepth are shown.
+ * The first breadcrumb is kept when there is room for it next to the current page.
+ */
+export function truncateBreadcrumbs(breadcrumbs: Breadcrumb[], maxDepth: number) {
+  if (maxDepth <= 0 || breadcrumbs.length <= maxDepth) {
+    return { leading: breadcrumbs, hidden: [], trailing: [] };
+  }
+
+  const leading = maxDepth > 1 ? breadcrumbs.slice(0, 1) : [];
+  const trailing = breadcrumbs.slice(breadcrumbs.length - (maxDepth - leading.length));
+  const hidden = breadcrumbs.slice(leading.length, breadcrumbs.length - trailing.length);
+
+  return { leading, hidden, trailing };
+}
@@ -27,6 +27,8 @@ jest.mock('@grafana/runtime', () => ({
     },
     appSubUrl: '',
     verifyEmailEnabled: false,
+    smtpEnabled: true,
+    resetPasswordEnabled: true,
   },
 }));
 
@@ -160,4 +162,23 @@ describe('Login Page', () => {
       'You have exceeded the number of login attempts for this user. Please try again later.'
     );
   });
+
+  describe('forgot password link', () => {
+    const { smtpEnabled, resetPasswordEnabled } = runtimeMock.config;
+
+    afterEach(() => {
+      Object.assign(runtimeMock.config, { smtpEnabled, resetPasswordEnabled });
+    });
+
+    it.each([
+      ['SMTP is not configured', { smtpEnabled: false, resetPasswordEnabled: true }],
+      ['password reset is disabled', { smtpEnabled: true, resetPasswordEnabled: false }],
+    ])('is hidden when %s', (_, settings) => {
+      Object.assign(runtimeMock.config, settings);
+
+      render(<LoginPage />);
+
+      expect(screen.queryByRole('link', { name: 'Forgot your password?' })).not.toBeInTheDocument();
+    });
+  });
 });
@@ -54,13 +54,15 @@ export const LoginPage = () => {
               {!disableLoginForm && (
                 <LoginForm onSubmit={login} loginHint={loginHint} passwordHint={passwordHint} isLoggingIn={isLoggingIn}>
                   <HorizontalGroup justify="flex-end">
-                    <LinkButton
-                      className={forgottenPasswordStyles}
-                      fill="text"
-                      href={`${config.appSubUrl}/user/password/send-reset-email`}
-                    >
-                      Forgot your password?
-                    </LinkButton>
+                    {config.resetPasswordEnabled && config.smtpEnabled && (
+                      <LinkButton
+                        className={forgottenPasswordStyles}
+                        fill="text"
+                        href={`${config.appSubUrl}/user/password/send-reset-email`}
+                      >
+                        Forgot your password?
+                      </LinkButton>
+                    )}
                   </HorizontalGroup>
                 </LoginForm>
               )}
@@ -1,4 +1,6 @@
-import { getSvgId, getSvgStyle, svgStyleCleanup } from './utils';
+import { config } from '@grafana/runtime';
+
+import { getSvgId, getSvgStyle, isSvgWithinSizeLimit, svgStyleCleanup } from './utils';
 
 const ID = 'TEST_ID';
 
@@ -55,4 +57,30 @@ describe('SanitizedSVG', () => {
       `<svg id="${svgId}" xmlns="http://www.w3.org/2000/svg" viewBox="0 0 24 24"><style type="text/css">#${svgId} .st0{fill:green;}</style><circle cx="12" cy="12" r="10" class="st0"/></svg>`
     );
   });
+
+  describe('size limit', () => {
+    const originalMaxInlineSVGBytes = config.maxInlineSVGBytes;
+
+    afterEach(() => {
+      config.maxInlineSVGBytes = originalMaxInlineSVGBytes;
+    });
+
+    it('should accept any SVG when there is no limit', () => {
+      config.maxInlineSVGBytes = 0;
+
+      expect(isSvgWithinSizeLimit(svgNoId)).toBe(true);
+    });
+
+    it('should accept SVGs within the limit', () => {
+      config.maxInlineSVGBytes = svgNoId.length;
+
+      expect(isSvgWithinSizeLimit(svgNoId)).toBe(true);
+    });
+
+    it('should reject SVGs larger than the limit', () => {
+      config.maxInlineSVGBytes = 100;
+
+      expect(isSvgWithinSizeLimit(svgNoId)).toBe(false);
+    });
+  });
 });
@@ -2,8 +2,9 @@ import React from 'react';
 import SVG, { Props } from 'react-inlinesvg';
 
 import { textUtil } from '@grafana/data';
+import { config } from '@grafana/runtime';
 
-import { svgStyleCleanup } from './utils';
+import { isSvgWithinSizeLimit, svgStyleCleanup } from './utils';
 
 type SanitizedSVGProps = Props & { cleanStyle?: boolean };
 
@@ -14,7 +15,15 @@ export const SanitizedSVG = (props: SanitizedSVGProps) => {
 
 let cache = new Map<string, string>();
 
+// Huge SVGs can crash the renderer, throwing makes react-inlinesvg fail the load and call onError
+function checkSVGSize(code: string) {
+  if (!isSvgWithinSizeLimit(code)) {
+    throw new Error(`SVG is larger than the maximum of ${config.maxInlineSVGBytes} bytes`);
+  }
+}
+
 function getCleanSVG(code: string): string {
+  checkSVGSize(code);
   let clean = cache.get(code);
   if (!clean) {
     clean = textUtil.sanitizeSVGContent(code);
@@ -25,6 +34,7 @@ function getCleanSVG(code: string): string {
 }
 
 function getCleanSVGAndStyle(code: string): string {
+  checkSVGSize(code);
   let clean = cache.get(code);
   if (!clean) {
     clean = textUtil.sanitizeSVGContent(code);
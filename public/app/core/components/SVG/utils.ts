@@ -1,5 +1,7 @@
 import { v4 as uuidv4 } from 'uuid';
 
+import { config } from '@grafana/runtime';
+
 const MATCH_ID_INDEX = 2;
 const SVG_ID_INSERT_POS = 5;
 
@@ -28,3 +30,8 @@ export const svgStyleCleanup = (svgCode: string) => {
 
   return svgCode;
 };
+
+export const isSvgWithinSizeLimit = (svgCode: string) => {
+  const maxBytes = config.maxInlineSVGBytes;
+  return maxBytes <= 0 || new Blob([svgCode]).size <= maxBytes;
+};
@@ -1,19 +1,29 @@
-import { render, screen } from '@testing-library/react';
+import { act, render, screen } from '@testing-library/react';
 import React from 'react';
 
 import { TeamPicker } from './TeamPicker';
 
+const mockGet = jest.fn().mockResolvedValue({ teams: [] });
+const mockConfig = { teamPickerPageSize: 0 };
+
 jest.mock('@grafana/runtime', () => ({
+  get config() {
+    return mockConfig;
+  },
   getBackendSrv: () => {
     return {
-      get: () => {
-        return Promise.resolve([]);
-      },
+      get: mockGet,
     };
   },
 }));
 
 describe('TeamPicker', () => {
+  beforeEach(() => {
+    mockGet.mockReset();
+    mockGet.mockResolvedValue({ teams: [] });
+    mockConfig.teamPickerPageSize = 0;
+  });
+
   it('renders correctly', async () => {
     const props = {
       onSelected: () => {},
@@ -21,4 +31,43 @@ describe('TeamPicker', () => {
     render(<TeamPicker {...props} />);
     expect(await screen.findByTestId('teamPicker')).toBeInTheDocument();
   });
+
+  it('uses the default page size when none is configured', async () => {
+    const ref = React.createRef<TeamPicker>();
+    render(<TeamPicker ref={ref} onSelected={() => {}} />);
+
+    await ref.current!.search('');
+    expect(mockGet).toHaveBeenCalledWith('/api/teams/search?perpage=100&page=1&query=');
+  });
+
+  it('uses the configured page size', async () => {
+    mockConfig.teamPickerPageSize = 25;
+    const ref = React.createRef<TeamPicker>();
+    render(<TeamPicker ref={ref} onSelected={() => {}} />);
+
+    await ref.current!.search('');
+    expect(mockGet).toHaveBeenCalledWith('/api/teams/search?perpage=25&page=1&query=');
+  });
+
+  it('loads the next page when scrolled to the bottom', async () => {
+    mockConfig.teamPickerPageSize = 1;
+    mockGet.mockResolvedValue({ teams: [{ id: 1, name: 'Team' }], totalCount: 2 });
+    const ref = React.createRef<TeamPicker>();
+    render(<TeamPicker ref={ref} onSelected={() => {}} />);
+
+    await act(async () => {
+      await ref.current!.search('te');
+    });
+    await act(async () => {
+      await ref.current!.loadMore();
+    });
+    expect(mockGet).toHaveBeenLastCalledWith('/api/teams/search?perpage=1&page=2&query=te');
+    expect(ref.current!.state.options).toHaveLength(2);
+
+    mockGet.mockClear();
+    await act(async () => {
+      await ref.current!.loadMore();
+    });
+    expect(mockGet).not.toHaveBeenCalled();
+  });
 });
@@ -1,11 +1,13 @@
-import { debounce, DebouncedFuncLeading, isNil } from 'lodash';
+import { debounce, DebouncedFuncLeading } from 'lodash';
 import React, { Component } from 'react';
 
 import { SelectableValue } from '@grafana/data';
-import { getBackendSrv } from '@grafana/runtime';
-import { AsyncSelect } from '@grafana/ui';
+import { config, getBackendSrv } from '@grafana/runtime';
+import { Select } from '@grafana/ui';
 import { Team } from 'app/types';
 
+const DEFAULT_PAGE_SIZE = 100;
+
 export interface Props {
   onSelected: (team: SelectableValue<Team>) => void;
   className?: string;
@@ -15,6 +17,10 @@ export interface Props {
 export interface State {
   isLoading: boolean;
   value?: SelectableValue<Team>;
+  options: Array<SelectableValue<Team>>;
+  query: string;
+  page: number;
+  hasMore: boolean;
 }
 
 export class TeamPicker extends Component<Props, State> {
@@ -22,8 +28,9 @@ export class TeamPicker extends Component<Props, State> {
 
   constructor(props: Props) {
     super(props);
-    this.state = { isLoading: false };
+    this.state = { isLoading: false, options: [], query: '', page: 1, hasMore: false };
     this.search = this.search.bind(this);
+    this.loadMore = this.loadMore.bind(this);
 
     this.debouncedSearch = debounce(this.search, 300, {
       leading: true,
@@ -32,6 +39,8 @@ export class TeamPicker extends Component<Props, State> {
   }
 
   componentDidMount(): void {
+    this.search();
+
     const { teamId } = this.props;
     if (!teamId) {
       return;
@@ -50,16 +59,13 @@ export class TeamPicker extends Component<Props, State> {
       });
   }
 
-  search(query?: string) {
+  search(query = '', page = 1) {
     this.setState({ isLoading: true });
 
-    if (isNil(query)) {
-      query = '';
-    }
-
+    const perPage = config.teamPickerPageSize || DEFAULT_PAGE_SIZE;
     return getBackendSrv()
-      .get(`/api/teams/search?perpage=100&page=1&query=${query}`)
-      .then((result: { teams: Team[] }) => {
+      .get(`/api/teams/search?perpage=${perPage}&page=${page}&query=${query}`)
+      .then((result: { teams: Team[]; totalCount: number }) => {
         const teams: Array<SelectableValue<Team>> = result.teams.map((team) => {
           return {
             value: team,
@@ -68,20 +74,41 @@ export class TeamPicker extends Component<Props, State> {
           };
         });
 
-        this.setState({ isLoading: false });
+        this.setState((state) => ({
+          isLoading: false,
+          // later pages extend the teams already shown for the same search
+          options: page > 1 ? [...state.options, ...teams] : teams,
+          query,
+          page,
+          hasMore: page * perPage < result.totalCount,
+        }));
         return teams;
       });
   }
 
+  loadMore() {
+    const { isLoading, hasMore, query, page } = this.state;
+    if (isLoading || !hasMore) {
+      return;
+    }
+
+    return this.search(query, page + 1);
+  }
+
   render() {
     const { onSelected, className } = this.props;
-    const { isLoading, value } = this.state;
+    const { isLoading, value, options } = this.state;
     return (
       <div className="user-picker" data-testid="teamPicker">
-        <AsyncSelect
+        <Select
           isLoading={isLoading}
-          defaultOptions={true}
-          loadOptions={this.debouncedSearch}
+          options={options}
+          onInputChange={(query, { action }) => {
+            if (action === 'input-change') {
+              this.debouncedSearch(query);
+            }
+          }}
+          onMenuScrollToBottom={this.loadMore}
           value={value}
           onChange={onSelected}
           className={className}
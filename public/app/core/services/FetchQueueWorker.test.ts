@@ -6,8 +6,8 @@ import { FetchQueue, FetchQueueUpdate, FetchStatus } from './FetchQueue';
 import { FetchQueueWorker } from './FetchQueueWorker';
 import { ResponseQueue } from './ResponseQueue';
 
-const getTestContext = (http2Enabled = false) => {
-  const config: GrafanaBootConfig = { http2Enabled } as unknown as GrafanaBootConfig;
+const getTestContext = (http2Enabled = false, maxDatasourceConnections = 0) => {
+  const config: GrafanaBootConfig = { http2Enabled, maxDatasourceConnections } as unknown as GrafanaBootConfig;
   const dataUrl = 'http://localhost:3000/api/ds/query?=abc';
   const apiUrl = 'http://localhost:3000/api/alerts?state=all';
   const updates: Subject<FetchQueueUpdate> = new Subject<FetchQueueUpdate>();
@@ -92,6 +92,40 @@ describe('FetchQueueWorker', () => {
           expect(addMock.mock.calls).toEqual([['api', { url: 'http://localhost:3000/api/alerts?state=all' }]]);
         });
       });
+
+      describe('and max data source connections is configured', () => {
+        it('then no more data requests than the limit should be in progress', () => {
+          const { updates, addMock, dataUrl, apiUrl } = getTestContext(true, 2);
+          updates.next({
+            noOfPending: 3,
+            noOfInProgress: 1,
+            state: {
+              ['data1']: { state: FetchStatus.Pending, options: { url: dataUrl } },
+              ['data2']: { state: FetchStatus.Pending, options: { url: dataUrl } },
+              ['api']: { state: FetchStatus.Pending, options: { url: apiUrl } },
+            },
+          });
+
+          expect(addMock.mock.calls).toEqual([['api', { url: 'http://localhost:3000/api/alerts?state=all' }]]);
+        });
+
+        it('then data requests below the limit should pass', () => {
+          const { updates, addMock, dataUrl } = getTestContext(false, 8);
+          updates.next({
+            noOfPending: 2,
+            noOfInProgress: 5,
+            state: {
+              ['data1']: { state: FetchStatus.Pending, options: { url: dataUrl } },
+              ['data2']: { state: FetchStatus.Pending, options: { url: dataUrl } },
+            },
+          });
+
+          expect(addMock.mock.calls).toEqual([
+            ['data1', { url: 'http://localhost:3000/api/ds/query?=abc' }],
+            ['data2', { url: 'http://localhost:3000/api/ds/query?=abc' }],
+          ]);
+        });
+      });
     });
   });
 });
@@ -14,7 +14,7 @@ interface WorkerEntry {
 
 export class FetchQueueWorker {
   constructor(fetchQueue: FetchQueue, responseQueue: ResponseQueue, config: GrafanaBootConfig) {
-    const maxParallelRequests = config?.http2Enabled ? 1000 : 5; // for tests that don't mock GrafanaBootConfig the config param will be undefined
+    const maxParallelRequests = getMaxParallelRequests(config);
 
     // This will create an implicit live subscription for as long as this class lives.
     // But as FetchQueueWorker is used by the singleton backendSrv that also lives for as long as Grafana app lives
@@ -57,3 +57,11 @@ export class FetchQueueWorker {
       });
   }
 }
+
+function getMaxParallelRequests(config: GrafanaBootConfig): number {
+  // for tests that don't mock GrafanaBootConfig the config param will be undefined
+  if (config?.maxDatasourceConnections > 0) {
+    return config.maxDatasourceConnections;
+  }
+  return config?.http2Enabled ? 1000 : 5;
+}
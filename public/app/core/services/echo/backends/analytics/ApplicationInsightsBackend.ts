@@ -25,6 +25,7 @@ declare global {
 export interface ApplicationInsightsBackendOptions {
   connectionString: string;
   endpointUrl?: string;
+  samplingPercentage?: number;
 }
 
 export class ApplicationInsightsBackend implements EchoBackend<PageviewEchoEvent, ApplicationInsightsBackendOptions> {
@@ -35,6 +36,7 @@ export class ApplicationInsightsBackend implements EchoBackend<PageviewEchoEvent
       config: {
         connectionString: options.connectionString,
         endpointUrl: options.endpointUrl,
+        samplingPercentage: options.samplingPercentage,
       },
     };
 
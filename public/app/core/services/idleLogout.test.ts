@@ -0,0 +1,68 @@
+import { config } from '@grafana/runtime';
+
+import store from '../store';
+
+import { contextSrv } from './context_srv';
+import { initIdleLogout, LAST_ACTIVITY_KEY } from './idleLogout';
+
+describe('initIdleLogout', () => {
+  const originalConfig = {
+    autoLogoutOnIdle: config.autoLogoutOnIdle,
+    idleTimeoutSeconds: config.idleTimeoutSeconds,
+    signoutRedirectUrl: config.signoutRedirectUrl,
+    appSubUrl: config.appSubUrl,
+  };
+  const originalIsSignedIn = contextSrv.isSignedIn;
+  const originalLocation = window.location;
+  let stop: () => void;
+
+  beforeEach(() => {
+    jest.useFakeTimers();
+    contextSrv.isSignedIn = true;
+    config.appSubUrl = '/grafana';
+    Object.defineProperty(window, 'location', { value: { href: '' }, writable: true });
+  });
+
+  afterEach(() => {
+    stop();
+    jest.useRealTimers();
+    Object.assign(config, originalConfig);
+    contextSrv.isSignedIn = originalIsSignedIn;
+    Object.defineProperty(window, 'location', { value: originalLocation, writable: true });
+    store.delete(LAST_ACTIVITY_KEY);
+  });
+
+  it('should sign out through the logout endpoint after the idle timeout', () => {
+    config.autoLogoutOnIdle = true;
+    config.idleTimeoutSeconds = 60;
+    config.signoutRedirectUrl = 'https://sso.example.com/portal';
+
+    stop = initIdleLogout();
+    jest.advanceTimersByTime(60 * 1000);
+
+    // the logout endpoint redirects to the signout redirect URL
+    expect(window.location.href).toBe('/grafana/logout');
+  });
+
+  it('should not sign out while the user is active', () => {
+    config.autoLogoutOnIdle = true;
+    config.idleTimeoutSeconds = 60;
+
+    stop = initIdleLogout();
+    jest.advanceTimersByTime(50 * 1000);
+    window.dispatchEvent(new Event('keydown'));
+    jest.advanceTimersByTime(50 * 1000);
+
+    expect(window.location.href).toBe('');
+  });
+
+  it('should ignore the idle timeout when auto logout on idle is disabled', () => {
+    config.autoLogoutOnIdle = false;
+    config.idleTimeoutSeconds = 60;
+
+    stop = initIdleLogout();
+    jest.advanceTimersByTime(10 * 60 * 1000);
+
+    expect(window.location.href).toBe('');
+  });
+});
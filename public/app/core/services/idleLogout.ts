@@ -0,0 +1,58 @@
+import { config } from '@grafana/runtime';
+
+import store from '../store';
+
+import { contextSrv } from './context_srv';
+
+export const LAST_ACTIVITY_KEY = 'grafana.idleLogout.lastActivity';
+
+const ACTIVITY_EVENTS = ['mousemove', 'mousedown', 'keydown', 'scroll', 'touchstart', 'wheel'];
+const CHECK_INTERVAL_MS = 10 * 1000;
+// Only write the last activity to local storage once per second, mouse moves fire far more often
+const ACTIVITY_THROTTLE_MS = 1000;
+
+/**
+ * Signs the user out after they have been idle for idleTimeoutSeconds when autoLogoutOnIdle is enabled.
+ * The last activity is shared between tabs through local storage, so working in one tab keeps the others signed in.
+ * Signing out goes through the logout endpoint, which ends the session and redirects to signoutRedirectUrl if it is set.
+ * Returns a function that stops watching.
+ */
+export function initIdleLogout(): () => void {
+  const { autoLogoutOnIdle, idleTimeoutSeconds } = config;
+  if (!autoLogoutOnIdle || idleTimeoutSeconds <= 0 || !contextSrv.isSignedIn) {
+    return () => {};
+  }
+
+  let lastActivity = Date.now();
+  store.set(LAST_ACTIVITY_KEY, lastActivity);
+
+  const onActivity = () => {
+    const now = Date.now();
+    if (now - lastActivity >= ACTIVITY_THROTTLE_MS) {
+      lastActivity = now;
+      store.set(LAST_ACTIVITY_KEY, now);
+    }
+  };
+
+  const checkIdle = () => {
+    const sharedLastActivity = Number(store.get(LAST_ACTIVITY_KEY)) || lastActivity;
+    if (Date.now() - Math.max(lastActivity, sharedLastActivity) >= idleTimeoutSeconds * 1000) {
+      stop();
+      window.location.href = `${config.appSubUrl}/logout`;
+    }
+  };
+
+  for (const event of ACTIVITY_EVENTS) {
+    window.addEventListener(event, onActivity, { passive: true });
+  }
+  const interval = setInterval(checkIdle, Math.min(CHECK_INTERVAL_MS, idleTimeoutSeconds * 1000));
+
+  function stop() {
+    clearInterval(interval);
+    for (const event of ACTIVITY_EVENTS) {
+      window.removeEventListener(event, onActivity);
+    }
+  }
+
+  return stop;
+}
@@ -1,5 +1,6 @@
 import { DataSourceApi, dateTime, ExploreUrlState, LogsSortOrder } from '@grafana/data';
 import { serializeStateToUrlParam } from '@grafana/data/src/utils/url';
+import { config } from '@grafana/runtime';
 import { DataQuery } from '@grafana/schema';
 import { RefreshPicker } from '@grafana/ui';
 import store from 'app/core/store';
@@ -170,6 +171,29 @@ describe('updateHistory()', () => {
     expect(store.exists(key)).toBeTruthy();
     expect(store.getObject(key)).toMatchObject(expected);
   });
+
+  describe('history size', () => {
+    const originalMaxSessionQueryHistory = config.maxSessionQueryHistory;
+    const history = Array.from({ length: 150 }, (_, i) => ({ query: { refId: `${i}` }, ts: i }));
+
+    afterEach(() => {
+      config.maxSessionQueryHistory = originalMaxSessionQueryHistory;
+    });
+
+    test('should keep the default number of items', () => {
+      config.maxSessionQueryHistory = 0;
+
+      expect(updateHistory(history, datasourceId, [{ refId: 'new' }])).toHaveLength(100);
+    });
+
+    test('should keep the configured number of items', () => {
+      config.maxSessionQueryHistory = 10;
+
+      const updated = updateHistory(history, datasourceId, [{ refId: 'new' }]);
+      expect(updated).toHaveLength(10);
+      expect(updated[0].query).toEqual({ refId: 'new' });
+    });
+  });
 });
 
 describe('hasNonEmptyQuery', () => {
@@ -302,8 +302,9 @@ export function updateHistory<T extends DataQuery>(
     updatedHistory = [{ query, ts }, ...updatedHistory];
   });
 
-  if (updatedHistory.length > MAX_HISTORY_ITEMS) {
-    updatedHistory = updatedHistory.slice(0, MAX_HISTORY_ITEMS);
+  const maxHistoryItems = config.maxSessionQueryHistory > 0 ? config.maxSessionQueryHistory : MAX_HISTORY_ITEMS;
+  if (updatedHistory.length > maxHistoryItems) {
+    updatedHistory = updatedHistory.slice(0, maxHistoryItems);
   }
 
   // Combine all queries of a datasource type into one history
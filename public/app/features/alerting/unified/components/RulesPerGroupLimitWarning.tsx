@@ -0,0 +1,17 @@
+import React from 'react';
+
+import { Alert } from '@grafana/ui';
+
+interface Props {
+  limit: number;
+}
+
+const RulesPerGroupLimitReached = ({ limit }: Props) => (
+  <Alert severity="warning" title="Evaluation group rule limit reached">
+    This group contains at least <strong>{limit}</strong> rules, which is the maximum configured in Grafana.
+    <br />
+    Consider splitting the rules into smaller evaluation groups.
+  </Alert>
+);
+
+export { RulesPerGroupLimitReached };
@@ -3,6 +3,7 @@ import React from 'react';
 import { Provider } from 'react-redux';
 import { byLabelText, byTestId, byText, byTitle } from 'testing-library-selector';
 
+import { config } from '@grafana/runtime';
 import { CombinedRuleNamespace } from 'app/types/unified-alerting';
 
 import {
@@ -30,6 +31,7 @@ const ui = {
   table: byTestId('dynamic-table'),
   tableRows: byTestId('row'),
   noRulesText: byText('This group does not contain alert rules.'),
+  rulesLimitWarning: byText('Evaluation group rule limit reached'),
 };
 mockRulerRuleGroup({
   name: 'group1',
@@ -192,4 +194,38 @@ describe('EditGroupModal component on grafana-managed alert rules', () => {
 
     expect(ui.folderLink.query()).not.toBeInTheDocument();
   });
+
+  describe('rules per group limit', () => {
+    afterEach(() => {
+      config.unifiedAlerting.maxRulesPerGroup = 0;
+    });
+
+    it('Should warn when the group reaches the configured limit', () => {
+      config.unifiedAlerting.maxRulesPerGroup = 2;
+
+      render(<EditCloudGroupModal namespace={grafanaNamespace} group={grafanaGroup1} onClose={jest.fn()} />, {
+        wrapper: getProvidersWrapper(),
+      });
+
+      expect(ui.rulesLimitWarning.get()).toBeInTheDocument();
+    });
+
+    it('Should not warn when the group is below the configured limit', () => {
+      config.unifiedAlerting.maxRulesPerGroup = 3;
+
+      render(<EditCloudGroupModal namespace={grafanaNamespace} group={grafanaGroup1} onClose={jest.fn()} />, {
+        wrapper: getProvidersWrapper(),
+      });
+
+      expect(ui.rulesLimitWarning.query()).not.toBeInTheDocument();
+    });
+
+    it('Should not warn when there is no limit', () => {
+      render(<EditCloudGroupModal namespace={grafanaNamespace} group={grafanaGroup1} onClose={jest.fn()} />, {
+        wrapper: getProvidersWrapper(),
+      });
+
+      expect(ui.rulesLimitWarning.query()).not.toBeInTheDocument();
+    });
+  });
 });
@@ -13,13 +13,14 @@ import { RulerRuleDTO } from 'app/types/unified-alerting-dto';
 
 import { useUnifiedAlertingSelector } from '../../hooks/useUnifiedAlertingSelector';
 import { rulesInSameGroupHaveInvalidFor, updateLotexNamespaceAndGroupAction } from '../../state/actions';
-import { checkEvaluationIntervalGlobalLimit } from '../../utils/config';
+import { checkEvaluationIntervalGlobalLimit, checkRulesPerGroupLimit } from '../../utils/config';
 import { getRulesSourceName, GRAFANA_RULES_SOURCE_NAME } from '../../utils/datasource';
 import { initialAsyncRequestState } from '../../utils/redux';
 import { AlertInfo, getAlertInfo, isRecordingRulerRule } from '../../utils/rules';
 import { parsePrometheusDuration, safeParseDurationstr } from '../../utils/time';
 import { DynamicTable, DynamicTableColumnProps, DynamicTableItemProps } from '../DynamicTable';
 import { EvaluationIntervalLimitExceeded } from '../InvalidIntervalWarning';
+import { RulesPerGroupLimitReached } from '../RulesPerGroupLimitWarning';
 import { MIN_TIME_RANGE_STEP_S } from '../rule-editor/GrafanaEvaluationBehavior';
 
 const ITEMS_PER_PAGE = 10;
@@ -224,6 +225,7 @@ export function EditCloudGroupModal(props: ModalProps): React.ReactElement {
   const rulesWithoutRecordingRules = compact(
     group.rules.map((r) => r.rulerRule).filter((rule) => !isRecordingRulerRule(rule))
   );
+  const rulesPerGroupLimit = checkRulesPerGroupLimit(group.rules.length);
   const hasSomeNoRecordingRules = rulesWithoutRecordingRules.length > 0;
   const modalTitle =
     intervalEditOnly || isGrafanaManagedGroup ? 'Edit evaluation group' : 'Edit namespace or evaluation group';
@@ -307,6 +309,8 @@ export function EditCloudGroupModal(props: ModalProps): React.ReactElement {
               <EvaluationIntervalLimitExceeded />
             )}
 
+            {rulesPerGroupLimit.reachesLimit && <RulesPerGroupLimitReached limit={rulesPerGroupLimit.limit} />}
+
             {!hasSomeNoRecordingRules && <div>This group does not contain alert rules.</div>}
             {hasSomeNoRecordingRules && (
               <>
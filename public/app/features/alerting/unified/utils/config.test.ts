@@ -1,6 +1,6 @@
 import { config } from '@grafana/runtime';
 
-import { checkEvaluationIntervalGlobalLimit } from './config';
+import { checkEvaluationIntervalGlobalLimit, checkRulesPerGroupLimit } from './config';
 
 describe('checkEvaluationIntervalGlobalLimit', () => {
   it('should NOT exceed limit if evaluate every is not valid duration', () => {
@@ -51,3 +51,29 @@ describe('checkEvaluationIntervalGlobalLimit', () => {
     expect(exceedsLimit).toBe(false);
   });
 });
+
+describe('checkRulesPerGroupLimit', () => {
+  afterEach(() => {
+    config.unifiedAlerting.maxRulesPerGroup = 0;
+  });
+
+  it('should NOT reach limit if no limit is configured', () => {
+    const { limit, reachesLimit } = checkRulesPerGroupLimit(1000);
+
+    expect(limit).toBe(0);
+    expect(reachesLimit).toBe(false);
+  });
+
+  it.each([
+    [5, 5, true],
+    [5, 6, true],
+    [5, 4, false],
+  ])('with a limit of %s and %s rules should report reachesLimit %s', (maxRulesPerGroup, rulesCount, expected) => {
+    config.unifiedAlerting.maxRulesPerGroup = maxRulesPerGroup;
+
+    const { limit, reachesLimit } = checkRulesPerGroupLimit(rulesCount);
+
+    expect(limit).toBe(maxRulesPerGroup);
+    expect(reachesLimit).toBe(expected);
+  });
+});
@@ -29,3 +29,10 @@ export function checkEvaluationIntervalGlobalLimit(alertGroupEvaluateEvery?: str
 
   return { globalLimit: evaluateEveryGlobalLimitMs, exceedsLimit };
 }
+
+export function checkRulesPerGroupLimit(rulesCount: number) {
+  // 0 (or missing) means there is no configured limit
+  const limit = config.unifiedAlerting.maxRulesPerGroup ?? 0;
+
+  return { limit, reachesLimit: limit > 0 && rulesCount >= limit };
+}
@@ -1,3 +1,4 @@
+import { config } from '@grafana/runtime';
 import { PromQuery } from 'app/plugins/datasource/prometheus/types';
 import { RulerAlertingRuleDTO } from 'app/types/unified-alerting-dto';
 
@@ -85,3 +86,23 @@ describe('formValuesToRulerGrafanaRuleDTO', () => {
     expect(alertingRulerRuleToRuleForm(rule)).toMatchSnapshot();
   });
 });
+
+describe('getDefaultFormValues', () => {
+  const originalDefaultAlertContactPoint = config.unifiedAlerting.defaultAlertContactPoint;
+
+  afterEach(() => {
+    config.unifiedAlerting.defaultAlertContactPoint = originalDefaultAlertContactPoint;
+  });
+
+  it('should pre-fill the configured default contact point for new rules', () => {
+    config.unifiedAlerting.defaultAlertContactPoint = 'team-oncall';
+
+    expect(getDefaultFormValues().contactPoint).toBe('team-oncall');
+  });
+
+  it('should not pre-fill a contact point when no default contact point is configured', () => {
+    config.unifiedAlerting.defaultAlertContactPoint = '';
+
+    expect(getDefaultFormValues().contactPoint).toBeUndefined();
+  });
+});
@@ -3,7 +3,7 @@ import React, { memo, useEffect, useMemo } from 'react';
 import AutoSizer from 'react-virtualized-auto-sizer';
 
 import { GrafanaTheme2 } from '@grafana/data';
-import { reportInteraction } from '@grafana/runtime';
+import { config, reportInteraction } from '@grafana/runtime';
 import { FilterInput, useStyles2 } from '@grafana/ui';
 import { Page } from 'app/core/components/Page/Page';
 import { GrafanaRouteComponentProps } from 'app/core/navigation/types';
@@ -126,6 +126,7 @@ const BrowseDashboardsPage = memo(({ match }: Props) => {
           placeholder={getSearchPlaceholder(searchState.includePanels)}
           value={searchState.query}
           escapeRegex={false}
+          maxLength={config.maxSearchQueryLength || undefined}
           onChange={(e) => stateManager.onQueryChange(e)}
         />
 
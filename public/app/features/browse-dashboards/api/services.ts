@@ -7,7 +7,10 @@ import { DashboardViewItem } from 'app/features/search/types';
 import { contextSrv } from '../../../core/core';
 import { AccessControlAction } from '../../../types';
 
-export const PAGE_SIZE = 50;
+const DEFAULT_PAGE_SIZE = 50;
+
+// folder trees load max_folder_tree_nodes items at a time when it's configured
+export const PAGE_SIZE = config.maxFolderTreeNodes || DEFAULT_PAGE_SIZE;
 
 export async function listFolders(
   parentUID?: string,
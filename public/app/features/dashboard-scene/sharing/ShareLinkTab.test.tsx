@@ -28,6 +28,7 @@ describe('ShareLinkTab', () => {
 
     config.appUrl = 'http://dashboards.grafana.com/grafana/';
     config.rendererAvailable = true;
+    config.rendering = { ...config.rendering, available: true, supportedFormats: ['png'] };
     config.bootData.user.orgId = 1;
     config.featureToggles.dashboardSceneForViewers = true;
     locationService.push('/scenes/dashboard/dash-1?from=now-6h&to=now');
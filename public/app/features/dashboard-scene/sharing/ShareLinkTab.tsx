@@ -10,7 +10,7 @@ import { t, Trans } from 'app/core/internationalization';
 import { createShortLink } from 'app/core/utils/shortLinks';
 import { ThemePicker } from 'app/features/dashboard/components/ShareModal/ThemePicker';
 import { trackDashboardSharingActionPerType } from 'app/features/dashboard/components/ShareModal/analytics';
-import { shareDashboardType } from 'app/features/dashboard/components/ShareModal/utils';
+import { isRenderFormatSupported, shareDashboardType } from 'app/features/dashboard/components/ShareModal/utils';
 
 import { DashboardScene } from '../scene/DashboardScene';
 import { getDashboardUrl } from '../utils/urlBuilders';
@@ -179,7 +179,7 @@ function ShareLinkTabRenderer({ model }: SceneComponentProps<ShareLinkTab>) {
         </Field>
       </FieldSet>
 
-      {panel && config.rendererAvailable && (
+      {panel && isRenderFormatSupported('png') && (
         <>
           {isDashboardSaved && (
             <div className="gf-form">
@@ -201,7 +201,7 @@ function ShareLinkTabRenderer({ model }: SceneComponentProps<ShareLinkTab>) {
         </>
       )}
 
-      {panel && !config.rendererAvailable && (
+      {panel && !config.rendering.available && (
         <Alert
           severity="info"
           title={t('share-modal.link.render-alert', 'Image renderer plugin not installed')}
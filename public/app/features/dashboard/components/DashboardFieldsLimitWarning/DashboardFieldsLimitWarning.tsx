@@ -0,0 +1,21 @@
+import React from 'react';
+
+import { Alert } from '@grafana/ui';
+import { t } from 'app/core/internationalization';
+
+interface Props {
+  count: number;
+  limit: number;
+}
+
+export function DashboardFieldsLimitWarning({ count, limit }: Props) {
+  return (
+    <Alert severity="warning" title={t('dashboard.fields-limit-warning.title', 'Dashboard exceeds the field limit')}>
+      {t(
+        'dashboard.fields-limit-warning.body',
+        'This dashboard has {{fields}} JSON fields, more than the maximum of {{limit}} configured in Grafana. Very large dashboards can make the editor slow.',
+        { fields: count, limit }
+      )}
+    </Alert>
+  );
+}
@@ -0,0 +1,66 @@
+import { render, screen } from '@testing-library/react';
+import React from 'react';
+import { Provider } from 'react-redux';
+import { Router } from 'react-router-dom';
+import { getGrafanaContextMock } from 'test/mocks/getGrafanaContextMock';
+
+import { config, locationService } from '@grafana/runtime';
+import { GrafanaContext } from 'app/core/context/GrafanaContext';
+
+import { configureStore } from '../../../../store/configureStore';
+import { DashboardModel } from '../../state';
+import { createDashboardModelFixture } from '../../state/__fixtures__/dashboardFixtures';
+
+import { DashboardSettings } from './DashboardSettings';
+
+jest.mock('@grafana/ui', () => ({
+  ...jest.requireActual('@grafana/ui'),
+  CodeEditor: () => null,
+}));
+
+function setup(dashboard: DashboardModel) {
+  const store = configureStore();
+  const sectionNav = {
+    main: { text: 'Dashboard' },
+    node: {
+      text: 'JSON Model',
+    },
+  };
+
+  return render(
+    <GrafanaContext.Provider value={getGrafanaContextMock()}>
+      <Provider store={store}>
+        <Router history={locationService.getHistory()}>
+          <DashboardSettings
+            editview="dashboard_json"
+            dashboard={dashboard}
+            sectionNav={sectionNav}
+            pageNav={sectionNav.node}
+          />
+        </Router>
+      </Provider>
+    </GrafanaContext.Provider>
+  );
+}
+
+describe('JsonEditorSettings', () => {
+  const originalMaxDashboardFields = config.maxDashboardFields;
+
+  afterEach(() => {
+    config.maxDashboardFields = originalMaxDashboardFields;
+  });
+
+  it('does not warn without a field limit', () => {
+    config.maxDashboardFields = 0;
+    setup(createDashboardModelFixture({ title: 'Big dashboard' }));
+
+    expect(screen.queryByText('Dashboard exceeds the field limit')).not.toBeInTheDocument();
+  });
+
+  it('warns when the dashboard has more fields than the limit', () => {
+    config.maxDashboardFields = 1;
+    setup(createDashboardModelFixture({ title: 'Big dashboard' }));
+
+    expect(screen.getByText('Dashboard exceeds the field limit')).toBeInTheDocument();
+  });
+});
@@ -1,5 +1,5 @@
 import { css } from '@emotion/css';
-import React, { useState } from 'react';
+import React, { useMemo, useState } from 'react';
 
 import { GrafanaTheme2 } from '@grafana/data';
 import { config } from '@grafana/runtime';
@@ -9,12 +9,15 @@ import { Trans } from 'app/core/internationalization';
 import { dashboardWatcher } from 'app/features/live/dashboard/dashboardWatcher';
 
 import { getDashboardSrv } from '../../services/DashboardSrv';
+import { checkDashboardFieldsLimit } from '../../utils/dashboardFields';
+import { DashboardFieldsLimitWarning } from '../DashboardFieldsLimitWarning/DashboardFieldsLimitWarning';
 
 import { SettingsPageProps } from './types';
 
 export function JsonEditorSettings({ dashboard, sectionNav }: SettingsPageProps) {
   const [dashboardJson, setDashboardJson] = useState<string>(JSON.stringify(dashboard.getSaveModelClone(), null, 2));
   const pageNav = config.featureToggles.dockedMegaMenu ? sectionNav.node.parentItem : undefined;
+  const fieldsLimit = useMemo(() => checkDashboardJsonFieldsLimit(dashboardJson), [dashboardJson]);
 
   const onClick = async () => {
     await getDashboardSrv().saveJSONDashboard(dashboardJson);
@@ -30,6 +33,9 @@ export function JsonEditorSettings({ dashboard, sectionNav }: SettingsPageProps)
           The JSON model below is the data structure that defines the dashboard. This includes dashboard settings, panel
           settings, layout, queries, and so on.
         </Trans>
+        {fieldsLimit.exceedsLimit && (
+          <DashboardFieldsLimitWarning count={fieldsLimit.count} limit={fieldsLimit.limit} />
+        )}
         <CodeEditor
           value={dashboardJson}
           language="json"
@@ -50,6 +56,15 @@ export function JsonEditorSettings({ dashboard, sectionNav }: SettingsPageProps)
   );
 }
 
+function checkDashboardJsonFieldsLimit(json: string) {
+  try {
+    return checkDashboardFieldsLimit(JSON.parse(json));
+  } catch (e) {
+    // invalid JSON is reported when saving
+    return checkDashboardFieldsLimit(undefined);
+  }
+}
+
 const getStyles = (theme: GrafanaTheme2) => ({
   wrapper: css({
     display: 'flex',
@@ -6,7 +6,7 @@ import { Router } from 'react-router-dom';
 import { getGrafanaContextMock } from 'test/mocks/getGrafanaContextMock';
 
 import { selectors } from '@grafana/e2e-selectors';
-import { locationService } from '@grafana/runtime';
+import { config, locationService } from '@grafana/runtime';
 import { GrafanaContext } from 'app/core/context/GrafanaContext';
 
 import { configureStore } from '../../../../store/configureStore';
@@ -85,6 +85,13 @@ describe('LinksSettings', () => {
   const assertRowHasText = (index: number, text: string) => {
     expect(within(getTableBodyRows()[index]).queryByText(text)).toBeInTheDocument();
   };
+  const originalDefaultDashboardLinkIcon = config.defaultDashboardLinkIcon;
+  const originalDefaultDashboardLinkTooltip = config.defaultDashboardLinkTooltip;
+
+  afterEach(() => {
+    config.defaultDashboardLinkIcon = originalDefaultDashboardLinkIcon;
+    config.defaultDashboardLinkTooltip = originalDefaultDashboardLinkTooltip;
+  });
 
   test('it renders a header and cta if no links', () => {
     const linklessDashboard = createDashboardModelFixture({ links: [] });
@@ -206,4 +213,18 @@ describe('LinksSettings', () => {
     expect(within(getTableBody()).queryByText(originalLinks[0].title)).not.toBeInTheDocument();
     expect(within(getTableBody()).queryByText('The first dashboard link')).toBeInTheDocument();
   });
+
+  test('it creates new links with the configured icon and tooltip', async () => {
+    config.defaultDashboardLinkIcon = 'info';
+    config.defaultDashboardLinkTooltip = 'Opens in the runbook';
+
+    const dashboard = buildTestDashboard();
+    setup(dashboard);
+
+    await userEvent.click(screen.getByRole('button', { name: /new/i }));
+
+    const link = dashboard.links[dashboard.links.length - 1];
+    expect(link.icon).toBe('info');
+    expect(link.tooltip).toBe('Opens in the runbook');
+  });
 });
@@ -5,7 +5,7 @@ import { config, locationService } from '@grafana/runtime';
 import { Page } from 'app/core/components/Page/Page';
 
 import { LinkSettingsEdit, LinkSettingsList } from '../LinksSettings';
-import { newLink } from '../LinksSettings/LinkSettingsEdit';
+import { getNewLink } from '../LinksSettings/LinkSettingsEdit';
 
 import { SettingsPageProps } from './types';
 
@@ -20,7 +20,7 @@ export function LinksSettings({ dashboard, sectionNav, editIndex }: SettingsPage
   };
 
   const onNew = () => {
-    dashboard.links = [...dashboard.links, { ...newLink }];
+    dashboard.links = [...dashboard.links, getNewLink()];
     setIsNew(true);
     locationService.partial({ editIndex: dashboard.links.length - 1 });
   };
@@ -1,6 +1,7 @@
 import React, { useState } from 'react';
 
 import { SelectableValue } from '@grafana/data';
+import { config } from '@grafana/runtime';
 import { CollapsableSection, TagsInput, Select, Field, Input, Checkbox, Button, IconName } from '@grafana/ui';
 
 import { DashboardLink, DashboardModel } from '../../state/DashboardModel';
@@ -18,6 +19,17 @@ export const newLink: DashboardLink = {
   includeVars: false,
 };
 
+/**
+ * Returns a new link with the configured default icon and tooltip
+ */
+export function getNewLink(): DashboardLink {
+  return {
+    ...newLink,
+    icon: config.defaultDashboardLinkIcon || newLink.icon,
+    tooltip: config.defaultDashboardLinkTooltip || newLink.tooltip,
+  };
+}
+
 const linkTypeOptions = [
   { value: 'dashboards', label: 'Dashboards' },
   { value: 'link', label: 'Link' },
@@ -42,7 +54,7 @@ type LinkSettingsEditProps = {
 };
 
 export const LinkSettingsEdit = ({ editLinkIdx, dashboard, onGoBack }: LinkSettingsEditProps) => {
-  const [linkSettings, setLinkSettings] = useState(editLinkIdx !== null ? dashboard.links[editLinkIdx] : newLink);
+  const [linkSettings, setLinkSettings] = useState(editLinkIdx !== null ? dashboard.links[editLinkIdx] : getNewLink());
 
   const onUpdate = (link: DashboardLink) => {
     const links = [...dashboard.links];
@@ -14,6 +14,7 @@ import { OptionPaneRenderProps } from './types';
 
 export function getPanelFrameCategory(props: OptionPaneRenderProps): OptionsPaneCategoryDescriptor {
   const { panel, onPanelConfigChange } = props;
+  const repeatsRow = panel.repeatScope === 'row';
   const descriptor = new OptionsPaneCategoryDescriptor({
     title: 'Panel options',
     id: 'Panel options',
@@ -103,6 +104,7 @@ export function getPanelFrameCategory(props: OptionPaneRenderProps): OptionsPane
                 onChange={(links) => onPanelConfigChange('links', links)}
                 getSuggestions={getPanelLinksVariableSuggestions}
                 data={[]}
+                maxLinks={config.maxPanelLinks}
               />
             );
           },
@@ -115,17 +117,49 @@ export function getPanelFrameCategory(props: OptionPaneRenderProps): OptionsPane
         id: 'Repeat options',
         isOpenDefault: false,
       })
+        .addItem(
+          new OptionsPaneItemDescriptor({
+            title: 'Repeat',
+            description: 'Repeat this panel or the row it is placed in.',
+            showIf: () => Boolean(panel.repeatScope),
+            render: function renderRepeatScope() {
+              const scopeOptions = [
+                { label: 'Panel', value: 'panel' },
+                { label: 'Row', value: 'row' },
+              ];
+
+              return (
+                <RadioButtonGroup
+                  options={scopeOptions}
+                  value={panel.repeatScope}
+                  onChange={(value) => {
+                    // keep the selected variable when moving the repeat between the panel and its row
+                    const repeat = value === 'row' ? panel.repeat : panel.rowRepeat;
+                    onPanelConfigChange(value === 'row' ? 'repeat' : 'rowRepeat', undefined);
+                    onPanelConfigChange(value === 'row' ? 'rowRepeat' : 'repeat', repeat);
+                    onPanelConfigChange('repeatScope', value);
+                  }}
+                />
+              );
+            },
+          })
+        )
         .addItem(
           new OptionsPaneItemDescriptor({
             title: 'Repeat by variable',
-            description:
-              'Repeat this panel for each value in the selected variable. This is not visible while in edit mode. You need to go back to dashboard and then update the variable or reload the dashboard.',
+            description: repeatsRow
+              ? 'Repeat the row of this panel for each value in the selected variable. This is not visible while in edit mode. You need to go back to dashboard and then update the variable or reload the dashboard.'
+              : 'Repeat this panel for each value in the selected variable. This is not visible while in edit mode. You need to go back to dashboard and then update the variable or reload the dashboard.',
             render: function renderRepeatOptions() {
               return (
                 <RepeatRowSelect
                   id="repeat-by-variable-select"
-                  repeat={panel.repeat}
+                  repeat={repeatsRow ? panel.rowRepeat : panel.repeat}
                   onChange={(value?: string | null) => {
+                    if (repeatsRow) {
+                      onPanelConfigChange('rowRepeat', value ?? undefined);
+                      return;
+                    }
                     onPanelConfigChange('repeat', value);
                   }}
                 />
@@ -7,6 +7,7 @@ import { panelModelAndPluginReady } from 'app/features/panel/state/reducers';
 import { ThunkResult } from 'app/types';
 
 import { DashboardModel, PanelModel } from '../../../state';
+import { getPanelRow, getRepeatScope } from '../../../state/utils';
 
 import {
   closeEditor,
@@ -20,6 +21,11 @@ import {
 export function initPanelEditor(sourcePanel: PanelModel, dashboard: DashboardModel): ThunkResult<void> {
   return async (dispatch) => {
     const panel = dashboard.initEditPanel(sourcePanel);
+    const row = getPanelRow(dashboard.panels, sourcePanel);
+    if (row) {
+      panel.rowRepeat = row.repeat;
+      panel.repeatScope = getRepeatScope(panel, row);
+    }
 
     dispatch(
       updateEditorInitState({
@@ -132,6 +138,12 @@ export function exitPanelEditor(): ThunkResult<void> {
         await dispatch(panelModelAndPluginReady({ key: sourcePanel.key, plugin: panel.plugin! }));
       }
 
+      const row = panel.repeatScope && dashboard ? getPanelRow(dashboard.panels, sourcePanel) : undefined;
+      if (dashboard && row && row.repeat !== panel.rowRepeat) {
+        row.setProperty('repeat', panel.rowRepeat);
+        dashboard.processRepeats();
+      }
+
       // Resend last query result on source panel query runner
       // But do this after the panel edit editor exit process has completed
       setTimeout(() => {
@@ -0,0 +1,61 @@
+import { render, screen } from '@testing-library/react';
+import React from 'react';
+
+import { config } from '@grafana/runtime';
+import { Dashboard } from '@grafana/schema';
+
+import { DashboardLimitsWarning } from './DashboardLimitsWarning';
+
+const saveModel = {
+  title: 'Dash',
+  schemaVersion: 38,
+  panels: [
+    { type: 'timeseries' },
+    { type: 'row', collapsed: false, panels: [] },
+    { type: 'row', collapsed: true, panels: [{ type: 'stat' }, { type: 'table' }] },
+  ],
+  templating: { list: [{ name: 'env' }, { name: 'cluster' }] },
+} as unknown as Dashboard;
+
+describe('DashboardLimitsWarning', () => {
+  const originalMaxDashboardPanels = config.maxDashboardPanels;
+  const originalMaxTemplateVariables = config.maxTemplateVariables;
+
+  afterEach(() => {
+    config.maxDashboardPanels = originalMaxDashboardPanels;
+    config.maxTemplateVariables = originalMaxTemplateVariables;
+  });
+
+  it('should not warn when the limits are unlimited', () => {
+    config.maxDashboardPanels = 0;
+    config.maxTemplateVariables = 0;
+
+    const { container } = render(<DashboardLimitsWarning saveModel={saveModel} />);
+
+    expect(container).toBeEmptyDOMElement();
+  });
+
+  it('should not count rows as panels', () => {
+    config.maxDashboardPanels = 3;
+
+    const { container } = render(<DashboardLimitsWarning saveModel={saveModel} />);
+
+    expect(container).toBeEmptyDOMElement();
+  });
+
+  it('should warn when the dashboard has more panels than allowed', () => {
+    config.maxDashboardPanels = 2;
+
+    render(<DashboardLimitsWarning saveModel={saveModel} />);
+
+    expect(screen.getByText('The dashboard has 3 panels, the maximum is 2.')).toBeInTheDocument();
+  });
+
+  it('should warn when the dashboard has more variables than allowed', () => {
+    config.maxTemplateVariables = 1;
+
+    render(<DashboardLimitsWarning saveModel={saveModel} />);
+
+    expect(screen.getByText('The dashboard has 2 variables, the maximum is 1.')).toBeInTheDocument();
+  });
+});
@@ -0,0 +1,52 @@
+import React from 'react';
+
+import { config } from '@grafana/runtime';
+import { Dashboard } from '@grafana/schema';
+import { Alert } from '@grafana/ui';
+
+interface Props {
+  saveModel: Dashboard;
+}
+
+/**
+ * Warns before saving a dashboard the server rejects because it has more panels or template variables than allowed.
+ * Rows don't count as panels, the panels inside collapsed rows do.
+ */
+export function DashboardLimitsWarning({ saveModel }: Props) {
+  const { maxDashboardPanels, maxTemplateVariables } = config;
+  const panelCount = getPanelCount(saveModel);
+  const variableCount = saveModel.templating?.list?.length ?? 0;
+
+  const warnings: string[] = [];
+  if (maxDashboardPanels > 0 && panelCount > maxDashboardPanels) {
+    warnings.push(`The dashboard has ${panelCount} panels, the maximum is ${maxDashboardPanels}.`);
+  }
+  if (maxTemplateVariables > 0 && variableCount > maxTemplateVariables) {
+    warnings.push(`The dashboard has ${variableCount} variables, the maximum is ${maxTemplateVariables}.`);
+  }
+
+  if (!warnings.length) {
+    return null;
+  }
+
+  return (
+    <Alert severity="warning" title="Dashboard exceeds the configured limits">
+      {warnings.map((warning) => (
+        <p key={warning}>{warning}</p>
+      ))}
+      Remove panels or variables before saving, or the save will fail.
+    </Alert>
+  );
+}
+
+function getPanelCount(saveModel: Dashboard): number {
+  let count = 0;
+  for (const panel of saveModel.panels ?? []) {
+    if (panel.type !== 'row') {
+      count++;
+    } else if ('panels' in panel) {
+      count += panel.panels?.length ?? 0;
+    }
+  }
+  return count;
+}
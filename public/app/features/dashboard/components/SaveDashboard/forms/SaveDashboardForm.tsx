@@ -9,6 +9,7 @@ import { Button, Checkbox, Form, TextArea, useStyles2, Stack } from '@grafana/ui
 import { DashboardModel } from 'app/features/dashboard/state';
 
 import { GenAIDashboardChangesButton } from '../../GenAI/GenAIDashboardChangesButton';
+import { DashboardLimitsWarning } from '../DashboardLimitsWarning';
 import { SaveDashboardData, SaveDashboardOptions } from '../types';
 
 interface FormDTO {
@@ -88,6 +89,7 @@ export const SaveDashboardForm = ({
                 aria-label={selectors.pages.SaveDashboardModal.saveVariables}
               />
             )}
+            <DashboardLimitsWarning saveModel={saveModel.clone} />
             <div className={styles.message}>
               {config.featureToggles.dashgpt && (
                 <GenAIDashboardChangesButton
@@ -79,6 +79,7 @@ describe('ShareModal', () => {
     });
     mockLocationHref('http://server/#!/test');
     config.rendererAvailable = true;
+    config.rendering = { ...config.rendering, available: true, supportedFormats: ['png'] };
     config.bootData.user.orgId = 1;
     props = {
       panel: new PanelModel({ id: 22, options: {}, fieldConfig: { defaults: {}, overrides: [] } }),
@@ -171,6 +172,7 @@ describe('when appUrl is set in the grafana config', () => {
     originalBootData = config.bootData;
     config.appUrl = 'http://dashboards.grafana.com/';
     config.rendererAvailable = true;
+    config.rendering = { ...config.rendering, available: true, supportedFormats: ['png'] };
     config.bootData.user.orgId = 1;
   });
 
@@ -8,7 +8,7 @@ import { t, Trans } from 'app/core/internationalization';
 import { ThemePicker } from './ThemePicker';
 import { trackDashboardSharingActionPerType } from './analytics';
 import { ShareModalTabProps } from './types';
-import { buildImageUrl, buildShareUrl, shareDashboardType } from './utils';
+import { buildImageUrl, buildShareUrl, isRenderFormatSupported, shareDashboardType } from './utils';
 
 export interface Props extends ShareModalTabProps {}
 
@@ -134,7 +134,7 @@ export class ShareLink extends PureComponent<Props, State> {
           </Field>
         </FieldSet>
 
-        {panel && config.rendererAvailable && (
+        {panel && isRenderFormatSupported('png') && (
           <>
             {isDashboardSaved && (
               <div className="gf-form">
@@ -160,7 +160,7 @@ export class ShareLink extends PureComponent<Props, State> {
           </>
         )}
 
-        {panel && !config.rendererAvailable && (
+        {panel && !config.rendering.available && (
           <Alert
             severity="info"
             title={t('share-modal.link.render-alert', 'Image renderer plugin not installed')}
@@ -0,0 +1,77 @@
+import { render, screen } from '@testing-library/react';
+import React from 'react';
+
+import { config } from '@grafana/runtime';
+
+import { Snapshot } from '../../services/SnapshotSrv';
+import { createDashboardModelFixture } from '../../state/__fixtures__/dashboardFixtures';
+
+import { ShareSnapshot } from './ShareSnapshot';
+
+const mockGetSnapshots = jest.fn<Promise<Snapshot[]>, []>();
+
+jest.mock('../../services/SnapshotSrv', () => ({
+  getDashboardSnapshotSrv: () => ({
+    getSharingOptions: () =>
+      Promise.resolve({
+        externalEnabled: true,
+        externalSnapshotName: 'Publish externally',
+        externalSnapshotURL: 'https://snapshots.example.com',
+        snapshotEnabled: true,
+      }),
+    getSnapshots: mockGetSnapshots,
+  }),
+}));
+
+const snapshots: Snapshot[] = [
+  { key: 'a', name: 'External A', external: true },
+  { key: 'b', name: 'External B', external: true },
+  { key: 'c', name: 'Local C', external: false },
+];
+
+describe('ShareSnapshot', () => {
+  const originalMaxExternalSnapshots = config.maxExternalSnapshots;
+
+  beforeEach(() => {
+    mockGetSnapshots.mockResolvedValue(snapshots);
+  });
+
+  afterEach(() => {
+    config.maxExternalSnapshots = originalMaxExternalSnapshots;
+  });
+
+  function renderShareSnapshot() {
+    const dashboard = createDashboardModelFixture({ title: 'Snapshot me' });
+    render(<ShareSnapshot dashboard={dashboard} onDismiss={jest.fn()} />);
+  }
+
+  it('allows publishing external snapshots when unlimited', async () => {
+    config.maxExternalSnapshots = 0;
+    renderShareSnapshot();
+
+    expect(await screen.findByRole('button', { name: 'Publish externally' })).toBeEnabled();
+    expect(mockGetSnapshots).not.toHaveBeenCalled();
+  });
+
+  it('allows publishing external snapshots below the limit', async () => {
+    config.maxExternalSnapshots = 3;
+    renderShareSnapshot();
+
+    expect(await screen.findByRole('button', { name: 'Publish externally' })).toBeEnabled();
+  });
+
+  it('does not allow publishing external snapshots once the limit is reached', async () => {
+    config.maxExternalSnapshots = 2;
+    renderShareSnapshot();
+
+    expect(await screen.findByRole('button', { name: 'Publish externally' })).toBeDisabled();
+  });
+
+  it('allows publishing external snapshots when the snapshots cannot be loaded', async () => {
+    config.maxExternalSnapshots = 2;
+    mockGetSnapshots.mockRejectedValue(new Error('failed'));
+    renderShareSnapshot();
+
+    expect(await screen.findByRole('button', { name: 'Publish externally' })).toBeEnabled();
+  });
+});
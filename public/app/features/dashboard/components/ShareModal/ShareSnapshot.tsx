@@ -1,7 +1,7 @@
 import React, { PureComponent } from 'react';
 
 import { isEmptyObject, SelectableValue } from '@grafana/data';
-import { getBackendSrv } from '@grafana/runtime';
+import { config, getBackendSrv } from '@grafana/runtime';
 import { Button, ClipboardButton, Field, Input, LinkButton, Modal, Select, Spinner } from '@grafana/ui';
 import { t, Trans } from 'app/core/internationalization';
 import { getTimeSrv } from 'app/features/dashboard/services/TimeSrv';
@@ -28,6 +28,7 @@ interface State {
   deleteUrl: string;
   timeoutSeconds: number;
   externalEnabled: boolean;
+  externalLimitReached: boolean;
   sharingButtonText: string;
 }
 
@@ -66,6 +67,7 @@ export class ShareSnapshot extends PureComponent<Props, State> {
       snapshotUrl: '',
       deleteUrl: '',
       externalEnabled: false,
+      externalLimitReached: false,
       sharingButtonText: '',
     };
   }
@@ -79,9 +81,23 @@ export class ShareSnapshot extends PureComponent<Props, State> {
     this.setState({
       sharingButtonText: shareOptions.externalSnapshotName,
       externalEnabled: shareOptions.externalEnabled,
+      externalLimitReached: shareOptions.externalEnabled && (await this.isExternalSnapshotLimitReached()),
     });
   }
 
+  async isExternalSnapshotLimitReached() {
+    if (config.maxExternalSnapshots <= 0) {
+      return false;
+    }
+    try {
+      const snapshots = await getDashboardSnapshotSrv().getSnapshots();
+      return snapshots.filter((snapshot) => snapshot.external).length >= config.maxExternalSnapshots;
+    } catch (e) {
+      // the server still rejects external snapshots over the limit
+      return false;
+    }
+  }
+
   createSnapshot = (external?: boolean) => () => {
     const { timeoutSeconds } = this.state;
     this.dashboard.snapshot = {
@@ -216,8 +232,15 @@ export class ShareSnapshot extends PureComponent<Props, State> {
 
   renderStep1() {
     const { onDismiss } = this.props;
-    const { snapshotName, selectedExpireOption, timeoutSeconds, isLoading, sharingButtonText, externalEnabled } =
-      this.state;
+    const {
+      snapshotName,
+      selectedExpireOption,
+      timeoutSeconds,
+      isLoading,
+      sharingButtonText,
+      externalEnabled,
+      externalLimitReached,
+    } = this.state;
 
     const snapshotNameTranslation = t('share-modal.snapshot.name', `Snapshot name`);
     const expireTranslation = t('share-modal.snapshot.expire', `Expire`);
@@ -265,7 +288,19 @@ export class ShareSnapshot extends PureComponent<Props, State> {
             <Trans i18nKey="share-modal.snapshot.cancel-button">Cancel</Trans>
           </Button>
           {externalEnabled && (
-            <Button variant="secondary" disabled={isLoading} onClick={this.createSnapshot(true)}>
+            <Button
+              variant="secondary"
+              disabled={isLoading || externalLimitReached}
+              tooltip={
+                externalLimitReached
+                  ? t(
+                      'share-modal.snapshot.external-limit-reached',
+                      'The maximum number of external snapshots has been reached'
+                    )
+                  : undefined
+              }
+              onClick={this.createSnapshot(true)}
+            >
               {sharingButtonText}
             </Button>
           )}
@@ -1,6 +1,7 @@
 import { TimeRange } from '@grafana/data';
+import { config } from '@grafana/runtime';
 
-import { buildParams } from './utils';
+import { buildParams, isRenderFormatSupported } from './utils';
 
 describe('buildParams', () => {
   it.each`
@@ -44,3 +45,25 @@ describe('buildParams', () => {
     }
   );
 });
+
+describe('isRenderFormatSupported', () => {
+  const originalRendering = config.rendering;
+
+  afterEach(() => {
+    config.rendering = originalRendering;
+  });
+
+  it('should support the formats reported by the image renderer', () => {
+    config.rendering = { ...originalRendering, available: true, supportedFormats: ['png', 'csv'] };
+
+    expect(isRenderFormatSupported('png')).toBe(true);
+    expect(isRenderFormatSupported('csv')).toBe(true);
+    expect(isRenderFormatSupported('pdf')).toBe(false);
+  });
+
+  it('should support no format when the image renderer is not installed', () => {
+    config.rendering = { ...originalRendering, available: false, supportedFormats: [] };
+
+    expect(isRenderFormatSupported('png')).toBe(false);
+  });
+});
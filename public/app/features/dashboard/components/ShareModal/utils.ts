@@ -1,4 +1,4 @@
-import { dateTime, locationUtil, TimeRange, urlUtil, rangeUtil } from '@grafana/data';
+import { dateTime, locationUtil, RenderingSettings, TimeRange, urlUtil, rangeUtil } from '@grafana/data';
 import { config } from '@grafana/runtime';
 import { createShortLink } from 'app/core/utils/shortLinks';
 import { getTimeSrv } from 'app/features/dashboard/services/TimeSrv';
@@ -118,6 +118,13 @@ export function buildImageUrl(
   return imageUrl;
 }
 
+/**
+ * Whether the image renderer is installed and can render the format.
+ */
+export function isRenderFormatSupported(format: RenderingSettings['supportedFormats'][number]): boolean {
+  return config.rendering.available && config.rendering.supportedFormats.includes(format);
+}
+
 export function buildIframeHtml(
   useCurrentTimeRange: boolean,
   dashboardUid: string,
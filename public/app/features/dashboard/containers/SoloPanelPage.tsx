@@ -2,6 +2,8 @@ import React, { Component } from 'react';
 import { connect, ConnectedProps } from 'react-redux';
 import AutoSizer from 'react-virtualized-auto-sizer';
 
+import { config } from '@grafana/runtime';
+
 import { GrafanaContext, GrafanaContextType } from 'app/core/context/GrafanaContext';
 import { GrafanaRouteComponentProps } from 'app/core/navigation/types';
 import { DashboardModel, PanelModel } from 'app/features/dashboard/state';
@@ -9,6 +11,7 @@ import { StoreState } from 'app/types';
 
 import { DashboardPanel } from '../dashgrid/DashboardPanel';
 import { initDashboard } from '../state/initDashboard';
+import { RequestThrottle } from '../utils/requestThrottle';
 
 export interface DashboardPageRouteParams {
   uid?: string;
@@ -43,6 +46,9 @@ export class SoloPanelPage extends Component<Props, State> {
     notFound: false,
   };
 
+  // embedded panels limit their own query rate so they don't overwhelm the server
+  requestThrottle = new RequestThrottle(config.maxEmbedRequestsPerMinute);
+
   componentDidMount() {
     const { match, route } = this.props;
 
@@ -93,6 +99,7 @@ export class SoloPanelPage extends Component<Props, State> {
         panel={this.state.panel}
         panelId={this.getPanelId()}
         timezone={this.props.queryParams.timezone}
+        requestThrottle={this.requestThrottle}
       />
     );
   }
@@ -102,9 +109,10 @@ export interface SoloPanelProps extends State {
   dashboard: DashboardModel | null;
   panelId: number;
   timezone?: string;
+  requestThrottle?: RequestThrottle;
 }
 
-export const SoloPanel = ({ dashboard, notFound, panel, panelId, timezone }: SoloPanelProps) => {
+export const SoloPanel = ({ dashboard, notFound, panel, panelId, timezone, requestThrottle }: SoloPanelProps) => {
   if (notFound) {
     return <div className="alert alert-error">Panel with id {panelId} not found</div>;
   }
@@ -132,6 +140,7 @@ export const SoloPanel = ({ dashboard, notFound, panel, panelId, timezone }: Sol
               lazy={false}
               timezone={timezone}
               hideMenu={true}
+              requestThrottle={requestThrottle}
             />
           );
         }}
@@ -6,6 +6,7 @@ import { StoreState } from 'app/types';
 import { initPanelState } from '../../panel/state/actions';
 import { setPanelInstanceState } from '../../panel/state/reducers';
 import { DashboardModel, PanelModel } from '../state';
+import { RequestThrottle } from '../utils/requestThrottle';
 
 import { LazyLoader } from './LazyLoader';
 import { PanelChromeAngular } from './PanelChromeAngular';
@@ -23,6 +24,7 @@ export interface OwnProps {
   lazy?: boolean;
   timezone?: string;
   hideMenu?: boolean;
+  requestThrottle?: RequestThrottle;
 }
 
 const mapStateToProps = (state: StoreState, props: OwnProps) => {
@@ -83,6 +85,7 @@ export class DashboardPanelUnconnected extends PureComponent<Props> {
       plugin,
       timezone,
       hideMenu,
+      requestThrottle,
       isDraggable = true,
     } = this.props;
 
@@ -120,6 +123,7 @@ export class DashboardPanelUnconnected extends PureComponent<Props> {
         onInstanceStateChange={this.onInstanceStateChange}
         timezone={timezone}
         hideMenu={hideMenu}
+        requestThrottle={requestThrottle}
       />
     );
   };
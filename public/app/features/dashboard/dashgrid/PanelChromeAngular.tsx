@@ -208,6 +208,8 @@ export class PanelChromeAngularUnconnected extends PureComponent<Props, State> {
         hoverHeaderOffset={hoverHeaderOffset}
         hoverHeader={panelChromeProps.hasOverlayHeader()}
         displayMode={transparent ? 'transparent' : 'default'}
+        borderStyle={panel.borderStyle}
+        showDescription={panel.showDescription}
         onCancelQuery={panelChromeProps.onCancelQuery}
         onOpenMenu={panelChromeProps.onOpenMenu}
       >
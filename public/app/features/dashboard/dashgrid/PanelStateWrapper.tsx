@@ -46,6 +46,7 @@ import { getTimeSrv, TimeSrv } from '../services/TimeSrv';
 import { DashboardModel, PanelModel } from '../state';
 import { getPanelChromeProps } from '../utils/getPanelChromeProps';
 import { loadSnapshotData } from '../utils/loadSnapshotData';
+import { RequestThrottle } from '../utils/requestThrottle';
 
 import { PanelHeaderMenuWrapper } from './PanelHeader/PanelHeaderMenuWrapper';
 import { PanelLoadTimeMonitor } from './PanelLoadTimeMonitor';
@@ -68,6 +69,7 @@ export interface Props {
   onInstanceStateChange: (value: any) => void;
   timezone?: string;
   hideMenu?: boolean;
+  requestThrottle?: RequestThrottle;
 }
 
 export interface State {
@@ -84,6 +86,7 @@ export class PanelStateWrapper extends PureComponent<Props, State> {
   private subs = new Subscription();
   private eventFilter: EventFilterOptions = { onlyLocal: true };
   private panelOptionsLogger: PanelOptionsLogger | undefined = undefined;
+  private throttledRefresh: ReturnType<typeof setTimeout> | undefined;
 
   constructor(props: Props) {
     super(props);
@@ -239,6 +242,7 @@ export class PanelStateWrapper extends PureComponent<Props, State> {
 
   componentWillUnmount() {
     this.subs.unsubscribe();
+    clearTimeout(this.throttledRefresh);
     liveTimer.remove(this);
   }
 
@@ -353,6 +357,10 @@ export class PanelStateWrapper extends PureComponent<Props, State> {
         return;
       }
 
+      if (this.isRefreshThrottled()) {
+        return;
+      }
+
       panel.refreshWhenInView = false;
       panel.runAllPanelQueries({
         dashboardUID: dashboard.uid,
@@ -370,6 +378,25 @@ export class PanelStateWrapper extends PureComponent<Props, State> {
     }
   };
 
+  // Delays the refresh when the request throttle is exhausted, multiple throttled refreshes result in a single one
+  private isRefreshThrottled(): boolean {
+    const { requestThrottle } = this.props;
+    if (this.throttledRefresh) {
+      return true;
+    }
+
+    const waitMs = requestThrottle?.acquire() ?? 0;
+    if (waitMs <= 0) {
+      return false;
+    }
+
+    this.throttledRefresh = setTimeout(() => {
+      this.throttledRefresh = undefined;
+      this.onRefresh();
+    }, waitMs);
+    return true;
+  }
+
   onRender = () => {
     const stateUpdate = { renderCounter: this.state.renderCounter + 1 };
     this.setState(stateUpdate);
@@ -572,6 +599,8 @@ export class PanelStateWrapper extends PureComponent<Props, State> {
         hoverHeaderOffset={hoverHeaderOffset}
         hoverHeader={panelChromeProps.hasOverlayHeader()}
         displayMode={transparent ? 'transparent' : 'default'}
+        borderStyle={panel.borderStyle}
+        showDescription={panel.showDescription}
         onCancelQuery={panelChromeProps.onCancelQuery}
         onOpenMenu={panelChromeProps.onOpenMenu}
       >
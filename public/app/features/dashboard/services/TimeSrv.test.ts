@@ -306,6 +306,107 @@ describe('timeSrv', () => {
     });
   });
 
+  describe('pause refresh while editing', () => {
+    const originalPauseRefreshWhileEditing = config.pauseRefreshWhileEditing;
+
+    beforeEach(() => {
+      jest.useFakeTimers();
+      const contextSrv = new ContextSrvStub();
+      contextSrv.isGrafanaVisible.mockReturnValue(true);
+      timeSrv = new TimeSrv(contextSrv);
+      timeSrv.init(_dashboard);
+    });
+
+    afterEach(() => {
+      timeSrv.stopAutoRefresh();
+      jest.useRealTimers();
+      config.pauseRefreshWhileEditing = originalPauseRefreshWhileEditing;
+    });
+
+    it('should skip refreshes while a panel is edited when enabled', () => {
+      config.pauseRefreshWhileEditing = true;
+      _dashboard.panelInEdit = {};
+
+      timeSrv.setAutoRefresh('10s');
+      jest.advanceTimersByTime(30000);
+
+      expect(_dashboard.timeRangeUpdated).not.toHaveBeenCalled();
+      expect(timeSrv.refreshTimer).not.toBeUndefined();
+    });
+
+    it('should resume refreshes once the panel editor is closed', () => {
+      config.pauseRefreshWhileEditing = true;
+      _dashboard.panelInEdit = {};
+
+      timeSrv.setAutoRefresh('10s');
+      jest.advanceTimersByTime(10000);
+      _dashboard.panelInEdit = undefined;
+      jest.advanceTimersByTime(10000);
+
+      expect(_dashboard.timeRangeUpdated).toHaveBeenCalledTimes(1);
+      expect(_dashboard.timeRangeUpdated).toHaveBeenCalledWith(expect.anything(), true);
+    });
+
+    it('should keep refreshing while a panel is edited when disabled', () => {
+      config.pauseRefreshWhileEditing = false;
+      _dashboard.panelInEdit = {};
+
+      timeSrv.setAutoRefresh('10s');
+      jest.advanceTimersByTime(30000);
+
+      expect(_dashboard.timeRangeUpdated).toHaveBeenCalledTimes(3);
+    });
+  });
+
+  describe('pause refresh without interaction', () => {
+    const originalMaxRefreshesBeforePause = config.maxRefreshesBeforePause;
+
+    beforeEach(() => {
+      jest.useFakeTimers();
+      const contextSrv = new ContextSrvStub();
+      contextSrv.isGrafanaVisible.mockReturnValue(true);
+      timeSrv = new TimeSrv(contextSrv);
+      timeSrv.init(_dashboard);
+    });
+
+    afterEach(() => {
+      timeSrv.stopAutoRefresh();
+      jest.useRealTimers();
+      config.maxRefreshesBeforePause = originalMaxRefreshesBeforePause;
+    });
+
+    it('should stop refreshing after the configured number of refreshes', () => {
+      config.maxRefreshesBeforePause = 2;
+
+      timeSrv.setAutoRefresh('10s');
+      jest.advanceTimersByTime(50000);
+
+      expect(_dashboard.timeRangeUpdated).toHaveBeenCalledTimes(2);
+      expect(timeSrv.refreshTimer).not.toBeUndefined();
+    });
+
+    it('should refresh and resume refreshing once the user interacts', () => {
+      config.maxRefreshesBeforePause = 2;
+
+      timeSrv.setAutoRefresh('10s');
+      jest.advanceTimersByTime(30000);
+      document.dispatchEvent(new KeyboardEvent('keydown'));
+      expect(_dashboard.timeRangeUpdated).toHaveBeenCalledTimes(3);
+
+      jest.advanceTimersByTime(30000);
+      expect(_dashboard.timeRangeUpdated).toHaveBeenCalledTimes(5);
+    });
+
+    it('should keep refreshing when not configured', () => {
+      config.maxRefreshesBeforePause = 0;
+
+      timeSrv.setAutoRefresh('10s');
+      jest.advanceTimersByTime(50000);
+
+      expect(_dashboard.timeRangeUpdated).toHaveBeenCalledTimes(5);
+    });
+  });
+
   describe('isRefreshOutsideThreshold', () => {
     const originalNow = Date.now;
 
@@ -32,6 +32,7 @@ export class TimeSrv {
   timeAtLoad: RawTimeRange;
   refreshMS?: number;
   private autoRefreshBlocked?: boolean;
+  private refreshesWithoutInteraction = 0;
 
   constructor(private contextSrv: ContextSrv) {
     // default time
@@ -57,6 +58,18 @@ export class TimeSrv {
         this.refreshTimeModel();
       }
     });
+
+    for (const eventType of ['mousemove', 'keydown', 'wheel', 'touchstart']) {
+      document.addEventListener(eventType, () => this.onUserInteraction(), { passive: true });
+    }
+  }
+
+  private onUserInteraction() {
+    const wasPaused = this.isRefreshPausedForInactivity();
+    this.refreshesWithoutInteraction = 0;
+    if (wasPaused && this.refreshTimer) {
+      this.refreshTimeModel();
+    }
   }
 
   init(timeModel: TimeModel) {
@@ -219,6 +232,7 @@ export class TimeSrv {
     }
 
     this.stopAutoRefresh();
+    this.refreshesWithoutInteraction = 0;
 
     const currentUrlState = locationService.getSearchObject();
 
@@ -243,7 +257,9 @@ export class TimeSrv {
     this.refreshMS = intervalMs;
     this.refreshTimer = window.setTimeout(() => {
       this.startNextRefreshTimer(intervalMs);
-      this.refreshTimeModel();
+      if (!this.isRefreshPausedForEditing()) {
+        this.autoRefreshTimeModel();
+      }
     }, intervalMs);
 
     if (currentUrlState.refresh !== refresh) {
@@ -267,14 +283,35 @@ export class TimeSrv {
   private startNextRefreshTimer(afterMs: number) {
     this.refreshTimer = window.setTimeout(() => {
       this.startNextRefreshTimer(afterMs);
+      if (this.isRefreshPausedForEditing()) {
+        return;
+      }
       if (this.contextSrv.isGrafanaVisible()) {
-        this.refreshTimeModel();
+        this.autoRefreshTimeModel();
       } else {
         this.autoRefreshBlocked = true;
       }
     }, afterMs);
   }
 
+  // auto-refreshes are counted until the user interacts, and skipped once the configured maximum is reached
+  private autoRefreshTimeModel() {
+    if (this.isRefreshPausedForInactivity()) {
+      return;
+    }
+    this.refreshesWithoutInteraction++;
+    this.timeModel?.timeRangeUpdated(this.timeRange(), true);
+  }
+
+  private isRefreshPausedForInactivity(): boolean {
+    return config.maxRefreshesBeforePause > 0 && this.refreshesWithoutInteraction >= config.maxRefreshesBeforePause;
+  }
+
+  // auto-refresh keeps its schedule while a panel is edited, but the ticks are skipped
+  private isRefreshPausedForEditing(): boolean {
+    return config.pauseRefreshWhileEditing && Boolean(this.timeModel?.panelInEdit);
+  }
+
   stopAutoRefresh() {
     clearTimeout(this.refreshTimer);
     this.refreshTimer = undefined;
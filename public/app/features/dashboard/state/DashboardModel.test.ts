@@ -1,6 +1,7 @@
 import { keys as _keys } from 'lodash';
 
 import { dateTime, TimeRange, VariableHide } from '@grafana/data';
+import { config } from '@grafana/runtime';
 import { Dashboard, defaultVariableModel, RowPanel } from '@grafana/schema';
 
 import { getDashboardModel } from '../../../../test/helpers/getDashboardModel';
@@ -8,6 +9,7 @@ import { variableAdapters } from '../../variables/adapters';
 import { createAdHocVariableAdapter } from '../../variables/adhoc/adapter';
 import { createCustomVariableAdapter } from '../../variables/custom/adapter';
 import { createQueryVariableAdapter } from '../../variables/query/adapter';
+import * as variableActions from '../../variables/state/actions';
 import { setTimeSrv, TimeSrv } from '../services/TimeSrv';
 import { DashboardModel } from '../state/DashboardModel';
 import { PanelModel } from '../state/PanelModel';
@@ -1265,3 +1267,87 @@ describe('when exitPanelEditor is called', () => {
     expect(timeSrvMock.resumeAutoRefresh).toHaveBeenCalled();
   });
 });
+
+describe('when the time range is updated with minVariableRefreshIntervalSeconds', () => {
+  const originalMinVariableRefreshIntervalSeconds = config.minVariableRefreshIntervalSeconds;
+  const range: TimeRange = {
+    from: dateTime(new Date().getTime()).subtract(1, 'minutes'),
+    to: dateTime(new Date().getTime()),
+    raw: {
+      from: 'now-1m',
+      to: 'now',
+    },
+  };
+
+  function getTestContext() {
+    const dashboard = createDashboardModelFixture();
+    const onTimeRangeUpdatedSpy = jest.spyOn(variableActions, 'onTimeRangeUpdated').mockReturnValue(jest.fn());
+    setTimeSrv({ timeRange: () => range } as unknown as TimeSrv);
+    return { dashboard, onTimeRangeUpdatedSpy };
+  }
+
+  beforeEach(() => {
+    jest.useFakeTimers();
+    config.minVariableRefreshIntervalSeconds = 60;
+  });
+
+  afterEach(() => {
+    jest.useRealTimers();
+    jest.restoreAllMocks();
+    config.minVariableRefreshIntervalSeconds = originalMinVariableRefreshIntervalSeconds;
+  });
+
+  it('should skip the variable refresh of auto-refresh ticks within the interval', () => {
+    const { dashboard, onTimeRangeUpdatedSpy } = getTestContext();
+
+    dashboard.timeRangeUpdated(range, true);
+    jest.advanceTimersByTime(10000);
+    dashboard.timeRangeUpdated(range, true);
+
+    expect(onTimeRangeUpdatedSpy).toHaveBeenNthCalledWith(1, dashboard.uid, range, undefined, true);
+    expect(onTimeRangeUpdatedSpy).toHaveBeenNthCalledWith(2, dashboard.uid, range, undefined, false);
+  });
+
+  it('should always refresh the variables when the time range is changed', () => {
+    const { dashboard, onTimeRangeUpdatedSpy } = getTestContext();
+
+    dashboard.timeRangeUpdated(range, true);
+    jest.advanceTimersByTime(10000);
+    dashboard.timeRangeUpdated(range);
+
+    expect(onTimeRangeUpdatedSpy).toHaveBeenNthCalledWith(2, dashboard.uid, range, undefined, true);
+  });
+
+  it('should refresh the variables once the interval has passed after a skipped refresh', () => {
+    const { dashboard, onTimeRangeUpdatedSpy } = getTestContext();
+
+    dashboard.timeRangeUpdated(range, true);
+    jest.advanceTimersByTime(10000);
+    dashboard.timeRangeUpdated(range, true);
+    jest.advanceTimersByTime(50000);
+
+    expect(onTimeRangeUpdatedSpy).toHaveBeenCalledTimes(3);
+    expect(onTimeRangeUpdatedSpy).toHaveBeenNthCalledWith(3, dashboard.uid, range, undefined, true);
+  });
+
+  it('should not refresh the variables after the dashboard is destroyed', () => {
+    const { dashboard, onTimeRangeUpdatedSpy } = getTestContext();
+
+    dashboard.timeRangeUpdated(range, true);
+    dashboard.timeRangeUpdated(range, true);
+    dashboard.destroy();
+    jest.advanceTimersByTime(60000);
+
+    expect(onTimeRangeUpdatedSpy).toHaveBeenCalledTimes(2);
+  });
+
+  it('should keep the interval per dashboard', () => {
+    const { dashboard, onTimeRangeUpdatedSpy } = getTestContext();
+    const otherDashboard = createDashboardModelFixture({ uid: 'other' });
+
+    dashboard.timeRangeUpdated(range, true);
+    otherDashboard.timeRangeUpdated(range, true);
+
+    expect(onTimeRangeUpdatedSpy).toHaveBeenNthCalledWith(2, 'other', range, undefined, true);
+  });
+});
@@ -105,6 +105,8 @@ export class DashboardModel implements TimeModel {
   private appEventsSubscription: Subscription;
   private lastRefresh: number;
   private timeRangeUpdatedDuringEdit = false;
+  private lastVariableTimeRangeRefresh = 0;
+  private trailingVariableTimeRangeRefresh?: ReturnType<typeof setTimeout>;
   private originalDashboard: Dashboard | null = null;
 
   // ------------------
@@ -398,15 +400,38 @@ export class DashboardModel implements TimeModel {
     return { list: saveModelsWithoutNull };
   }
 
-  timeRangeUpdated(timeRange: TimeRange) {
+  timeRangeUpdated(timeRange: TimeRange, autoRefresh = false) {
     this.events.publish(new TimeRangeUpdatedEvent(timeRange));
-    dispatch(onTimeRangeUpdated(this.uid, timeRange));
+    dispatch(onTimeRangeUpdated(this.uid, timeRange, undefined, this.shouldRefreshVariables(autoRefresh)));
 
     if (this.panelInEdit) {
       this.timeRangeUpdatedDuringEdit = true;
     }
   }
 
+  // auto-refresh ticks refresh the variables at most once per minVariableRefreshIntervalSeconds,
+  // a skipped refresh is made up for once the interval has passed
+  private shouldRefreshVariables(autoRefresh: boolean): boolean {
+    const now = Date.now();
+    const dueIn = this.lastVariableTimeRangeRefresh + config.minVariableRefreshIntervalSeconds * 1000 - now;
+
+    if (!autoRefresh || dueIn <= 0) {
+      clearTimeout(this.trailingVariableTimeRangeRefresh);
+      this.trailingVariableTimeRangeRefresh = undefined;
+      this.lastVariableTimeRangeRefresh = now;
+      return true;
+    }
+
+    if (!this.trailingVariableTimeRangeRefresh) {
+      this.trailingVariableTimeRangeRefresh = setTimeout(() => {
+        this.trailingVariableTimeRangeRefresh = undefined;
+        this.timeRangeUpdated(getTimeSrv().timeRange());
+      }, dueIn);
+    }
+
+    return false;
+  }
+
   startRefresh(event: VariablesChangedEvent = { refreshAll: true, panelIds: [] }) {
     this.events.publish(new RefreshEvent());
     this.lastRefresh = Date.now();
@@ -953,6 +978,7 @@ export class DashboardModel implements TimeModel {
   }
 
   destroy() {
+    clearTimeout(this.trailingVariableTimeRangeRefresh);
     this.appEventsSubscription.unsubscribe();
     this.events.removeAllListeners();
     for (const panel of this.panels) {
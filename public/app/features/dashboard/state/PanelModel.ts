@@ -68,6 +68,8 @@ const notPersistedProperties: { [str: string]: boolean } = {
   key: true,
   isNew: true,
   refreshWhenInView: true,
+  repeatScope: true,
+  rowRepeat: true,
 };
 
 // For angular panels we need to clean up properties when changing type
@@ -177,6 +179,8 @@ export class PanelModel implements DataConfigSource, IPanelModel {
   description?: string;
   links?: DataLink[];
   declare transparent: boolean;
+  borderStyle?: 'none' | 'solid' | 'shadow';
+  showDescription?: boolean;
 
   libraryPanel?: LibraryPanelRef | LibraryPanel;
 
@@ -193,6 +197,9 @@ export class PanelModel implements DataConfigSource, IPanelModel {
   queryCachingTTL?: number | null;
   isNew?: boolean;
   refreshWhenInView = false;
+  // set on the edit clone of a panel inside a row, rowRepeat is applied to the row when the changes are applied
+  repeatScope?: 'panel' | 'row';
+  rowRepeat?: string;
 
   cachedPluginOptions: Record<string, PanelOptionsCache> = {};
   legend?: { show: boolean; sort?: string; sortDesc?: boolean };
@@ -577,6 +584,7 @@ export class PanelModel implements DataConfigSource, IPanelModel {
 
     const clone = new PanelModel(sourceModel);
     clone.isEditing = true;
+    clone.isNew = this.isNew;
     clone.plugin = this.plugin;
 
     const sourceQueryRunner = this.getQueryRunner();
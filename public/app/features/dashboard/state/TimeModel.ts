@@ -5,6 +5,9 @@ export interface TimeModel {
   fiscalYearStartMonth?: number;
   refresh?: string | false;
   timepicker: any;
+  // set while a panel is being edited
+  panelInEdit?: unknown;
   getTimezone(): TimeZone;
-  timeRangeUpdated(timeRange: TimeRange): void;
+  // autoRefresh is set for the ticks of dashboard auto-refresh
+  timeRangeUpdated(timeRange: TimeRange, autoRefresh?: boolean): void;
 }
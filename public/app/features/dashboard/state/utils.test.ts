@@ -1,7 +1,9 @@
+import { config } from '@grafana/runtime';
+
 import { REPEAT_DIR_HORIZONTAL } from '../../../core/constants';
 
 import { PanelModel } from './PanelModel';
-import { deleteScopeVars, isOnTheSameGridRow } from './utils';
+import { deleteScopeVars, getPanelRow, getRepeatScope, isOnTheSameGridRow } from './utils';
 
 describe('isOnTheSameGridRow', () => {
   describe('when source panel is next to a panel', () => {
@@ -61,3 +63,55 @@ describe('deleteScopeVars', () => {
     });
   });
 });
+
+describe('getPanelRow', () => {
+  it('should return the row the panel is placed in', () => {
+    const row = new PanelModel({ id: 1, type: 'row' });
+    const panelInRow = new PanelModel({ id: 2, type: 'timeseries' });
+
+    expect(getPanelRow([row, panelInRow], panelInRow)).toBe(row);
+  });
+
+  it('should return undefined when the panel is not inside a row', () => {
+    const panelOutsideRow = new PanelModel({ id: 1, type: 'timeseries' });
+    const row = new PanelModel({ id: 2, type: 'row' });
+
+    expect(getPanelRow([panelOutsideRow, row], panelOutsideRow)).toBeUndefined();
+  });
+});
+
+describe('getRepeatScope', () => {
+  const originalDefaultRepeatScope = config.defaultRepeatScope;
+
+  afterEach(() => {
+    config.defaultRepeatScope = originalDefaultRepeatScope;
+  });
+
+  it('should return panel when the panel already repeats', () => {
+    config.defaultRepeatScope = 'row';
+    const row = new PanelModel({ id: 1, type: 'row' });
+    const panel = new PanelModel({ id: 2, type: 'timeseries', repeat: 'server' });
+
+    expect(getRepeatScope(panel, row)).toBe('panel');
+  });
+
+  it('should return row when the row already repeats', () => {
+    config.defaultRepeatScope = 'panel';
+    const row = new PanelModel({ id: 1, type: 'row', repeat: 'server' });
+    const panel = new PanelModel({ id: 2, type: 'timeseries' });
+
+    expect(getRepeatScope(panel, row)).toBe('row');
+  });
+
+  it.each([
+    ['', 'panel'],
+    ['panel', 'panel'],
+    ['row', 'row'],
+  ] as const)('should return the default repeat scope %p for new repeats', (scope, expected) => {
+    config.defaultRepeatScope = scope;
+    const row = new PanelModel({ id: 1, type: 'row' });
+    const panel = new PanelModel({ id: 2, type: 'timeseries' });
+
+    expect(getRepeatScope(panel, row)).toBe(expected);
+  });
+});
@@ -1,3 +1,5 @@
+import { config } from '@grafana/runtime';
+
 import { REPEAT_DIR_HORIZONTAL } from '../../../core/constants';
 
 import { PanelModel } from './PanelModel';
@@ -27,3 +29,33 @@ export function deleteScopeVars(panels: PanelModel[]) {
     }
   }
 }
+
+/**
+ * Returns the row the panel is placed in, or undefined when the panel isn't inside a row.
+ */
+export function getPanelRow(panels: PanelModel[], panel: PanelModel): PanelModel | undefined {
+  const panelIndex = panels.findIndex((p) => p.id === panel.id);
+  for (let i = panelIndex - 1; i >= 0; i--) {
+    if (panels[i].type === 'row') {
+      return panels[i];
+    }
+  }
+
+  return undefined;
+}
+
+/**
+ * Returns whether the repeat options of a panel inside a row apply to the panel or to its row.
+ * An existing repeat decides the scope, the configured default repeat scope only applies to new repeats.
+ */
+export function getRepeatScope(panel: PanelModel, row: PanelModel): 'panel' | 'row' {
+  if (panel.repeat) {
+    return 'panel';
+  }
+
+  if (row.repeat) {
+    return 'row';
+  }
+
+  return config.defaultRepeatScope === 'row' ? 'row' : 'panel';
+}
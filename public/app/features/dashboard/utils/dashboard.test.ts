@@ -1,4 +1,10 @@
+import { MappingType, SpecialValueMap, SpecialValueMatch, ThresholdsMode } from '@grafana/data';
+import config from 'app/core/config';
+
+import { createDashboardModelFixture } from '../state/__fixtures__/dashboardFixtures';
+
 import {
+  onCreateNewPanel,
   updateDashboardUidLastUsedDatasource,
   getLastUsedDatasourceFromStorage,
   initLastUsedDatasourceKeyForDashboard,
@@ -76,3 +82,171 @@ describe('Last Used Datasource Local Storage', () => {
     });
   });
 });
+
+describe('onCreateNewPanel', () => {
+  const originalDefaultPanelMinInterval = config.defaultPanelMinInterval;
+  const originalDefaultPanelBorderStyle = config.defaultPanelBorderStyle;
+  const originalDefaultShowPanelDescription = config.defaultShowPanelDescription;
+  const originalDefaultTimeShift = config.defaultTimeShift;
+  const originalDefaultSpecialValueMappings = config.defaultSpecialValueMappings;
+  const originalDefaultThresholdSteps = config.defaultThresholdSteps;
+  const originalDefaultFillOpacity = config.defaultFillOpacity;
+
+  afterEach(() => {
+    config.defaultPanelMinInterval = originalDefaultPanelMinInterval;
+    config.defaultPanelBorderStyle = originalDefaultPanelBorderStyle;
+    config.defaultShowPanelDescription = originalDefaultShowPanelDescription;
+    config.defaultTimeShift = originalDefaultTimeShift;
+    config.defaultSpecialValueMappings = originalDefaultSpecialValueMappings;
+    config.defaultThresholdSteps = originalDefaultThresholdSteps;
+    config.defaultFillOpacity = originalDefaultFillOpacity;
+  });
+
+  it('should not set a min interval by default', () => {
+    config.defaultPanelMinInterval = '';
+    const dashboard = createDashboardModelFixture();
+
+    const id = onCreateNewPanel(dashboard);
+
+    expect(dashboard.getPanelById(id!)?.interval).toBeUndefined();
+  });
+
+  it('should set the configured min interval', () => {
+    config.defaultPanelMinInterval = '5s';
+    const dashboard = createDashboardModelFixture();
+
+    const id = onCreateNewPanel(dashboard);
+
+    expect(dashboard.getPanelById(id!)?.interval).toBe('5s');
+  });
+
+  it('should not set a border style by default', () => {
+    config.defaultPanelBorderStyle = '';
+    const dashboard = createDashboardModelFixture();
+
+    const id = onCreateNewPanel(dashboard);
+
+    expect(dashboard.getPanelById(id!)?.borderStyle).toBeUndefined();
+  });
+
+  it('should set the configured border style', () => {
+    config.defaultPanelBorderStyle = 'shadow';
+    const dashboard = createDashboardModelFixture();
+
+    const id = onCreateNewPanel(dashboard);
+
+    expect(dashboard.getPanelById(id!)?.borderStyle).toBe('shadow');
+  });
+
+  it('should not show the description inline by default', () => {
+    config.defaultShowPanelDescription = false;
+    const dashboard = createDashboardModelFixture();
+
+    const id = onCreateNewPanel(dashboard);
+
+    expect(dashboard.getPanelById(id!)?.showDescription).toBeUndefined();
+  });
+
+  it('should show the description inline when configured', () => {
+    config.defaultShowPanelDescription = true;
+    const dashboard = createDashboardModelFixture();
+
+    const id = onCreateNewPanel(dashboard);
+
+    expect(dashboard.getPanelById(id!)?.showDescription).toBe(true);
+  });
+
+  it('should not set a time shift by default', () => {
+    config.defaultTimeShift = '';
+    const dashboard = createDashboardModelFixture();
+
+    const id = onCreateNewPanel(dashboard);
+
+    expect(dashboard.getPanelById(id!)?.timeShift).toBeUndefined();
+  });
+
+  it('should set the configured time shift', () => {
+    config.defaultTimeShift = '1w';
+    const dashboard = createDashboardModelFixture();
+
+    const id = onCreateNewPanel(dashboard);
+
+    expect(dashboard.getPanelById(id!)?.timeShift).toBe('1w');
+  });
+
+  it('should not set a fill opacity by default', () => {
+    config.defaultFillOpacity = 0;
+    const dashboard = createDashboardModelFixture();
+
+    const id = onCreateNewPanel(dashboard);
+
+    expect(dashboard.getPanelById(id!)?.fieldConfig.defaults.custom).toBeUndefined();
+  });
+
+  it('should set the configured fill opacity', () => {
+    config.defaultFillOpacity = 25;
+    const dashboard = createDashboardModelFixture();
+
+    const id = onCreateNewPanel(dashboard);
+
+    expect(dashboard.getPanelById(id!)?.fieldConfig.defaults.custom).toEqual({ fillOpacity: 25 });
+  });
+
+  it('should not add value mappings by default', () => {
+    config.defaultSpecialValueMappings = [];
+    const dashboard = createDashboardModelFixture();
+
+    const id = onCreateNewPanel(dashboard);
+
+    expect(dashboard.getPanelById(id!)?.fieldConfig.defaults.mappings).toBeUndefined();
+  });
+
+  it('should not add value mappings when the setting is missing', () => {
+    // settings the server doesn't send can end up as null in the boot config
+    config.defaultSpecialValueMappings = null as unknown as SpecialValueMap[];
+    const dashboard = createDashboardModelFixture();
+
+    const id = onCreateNewPanel(dashboard);
+
+    expect(dashboard.getPanelById(id!)?.fieldConfig.defaults.mappings).toBeUndefined();
+  });
+
+  it('should add the configured special value mappings', () => {
+    const mappings: SpecialValueMap[] = [
+      { type: MappingType.SpecialValue, options: { match: SpecialValueMatch.NaN, result: { text: 'NaN' } } },
+    ];
+    config.defaultSpecialValueMappings = mappings;
+    const dashboard = createDashboardModelFixture();
+
+    const id = onCreateNewPanel(dashboard);
+
+    expect(dashboard.getPanelById(id!)?.fieldConfig.defaults.mappings).toEqual(mappings);
+  });
+
+  it('should not set thresholds by default', () => {
+    config.defaultThresholdSteps = [];
+    const dashboard = createDashboardModelFixture();
+
+    const id = onCreateNewPanel(dashboard);
+
+    expect(dashboard.getPanelById(id!)?.fieldConfig.defaults.thresholds).toBeUndefined();
+  });
+
+  it('should set the configured threshold steps', () => {
+    config.defaultThresholdSteps = [
+      { color: 'green', value: null },
+      { color: 'red', value: 95 },
+    ];
+    const dashboard = createDashboardModelFixture();
+
+    const id = onCreateNewPanel(dashboard);
+
+    expect(dashboard.getPanelById(id!)?.fieldConfig.defaults.thresholds).toEqual({
+      mode: ThresholdsMode.Absolute,
+      steps: [
+        { color: 'green', value: -Infinity },
+        { color: 'red', value: 95 },
+      ],
+    });
+  });
+});
@@ -1,6 +1,6 @@
 import { chain, cloneDeep, defaults, find } from 'lodash';
 
-import { PanelPluginMeta } from '@grafana/data';
+import { FieldConfig, PanelPluginMeta, ThresholdsMode } from '@grafana/data';
 import { locationService } from '@grafana/runtime';
 import config from 'app/core/config';
 import { LS_PANEL_COPY_KEY } from 'app/core/constants';
@@ -17,6 +17,43 @@ export function onCreateNewPanel(dashboard: DashboardModel, datasource?: string)
     isNew: true,
   };
 
+  if (config.defaultPanelMinInterval) {
+    newPanel.interval = config.defaultPanelMinInterval;
+  }
+
+  if (config.defaultPanelBorderStyle) {
+    newPanel.borderStyle = config.defaultPanelBorderStyle;
+  }
+
+  if (config.defaultShowPanelDescription) {
+    newPanel.showDescription = true;
+  }
+
+  if (config.defaultTimeShift) {
+    newPanel.timeShift = config.defaultTimeShift;
+  }
+
+  const fieldConfigDefaults: FieldConfig = {};
+  if (config.defaultThresholdSteps?.length) {
+    fieldConfigDefaults.thresholds = {
+      mode: ThresholdsMode.Absolute,
+      // the base step has a null value in JSON
+      steps: config.defaultThresholdSteps.map((step) => ({ ...step, value: step.value ?? -Infinity })),
+    };
+  }
+
+  if (config.defaultFillOpacity > 0) {
+    fieldConfigDefaults.custom = { fillOpacity: config.defaultFillOpacity };
+  }
+
+  if (config.defaultSpecialValueMappings?.length) {
+    fieldConfigDefaults.mappings = cloneDeep(config.defaultSpecialValueMappings);
+  }
+
+  if (Object.keys(fieldConfigDefaults).length) {
+    newPanel.fieldConfig = { defaults: fieldConfigDefaults, overrides: [] };
+  }
+
   dashboard.addPanel(newPanel);
   return newPanel.id;
 }
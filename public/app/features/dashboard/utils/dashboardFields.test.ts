@@ -0,0 +1,51 @@
+import { config } from '@grafana/runtime';
+
+import { checkDashboardFieldsLimit, countDashboardFields } from './dashboardFields';
+
+const dashboard = {
+  title: 'My dashboard',
+  tags: ['one', 'two'],
+  panels: [
+    { id: 1, targets: [{ refId: 'A' }] },
+    { id: 2, targets: [] },
+  ],
+};
+
+describe('countDashboardFields', () => {
+  it('counts the fields of nested objects', () => {
+    // title, tags, panels, 2 x (id, targets) and refId
+    expect(countDashboardFields(dashboard)).toBe(8);
+  });
+
+  it('does not count values without fields', () => {
+    expect(countDashboardFields(undefined)).toBe(0);
+    expect(countDashboardFields(null)).toBe(0);
+    expect(countDashboardFields('dashboard')).toBe(0);
+  });
+});
+
+describe('checkDashboardFieldsLimit', () => {
+  const originalMaxDashboardFields = config.maxDashboardFields;
+
+  afterEach(() => {
+    config.maxDashboardFields = originalMaxDashboardFields;
+  });
+
+  it('does not exceed the limit when there is none', () => {
+    config.maxDashboardFields = 0;
+
+    expect(checkDashboardFieldsLimit(dashboard).exceedsLimit).toBe(false);
+  });
+
+  it('does not exceed the limit with as many fields as the limit', () => {
+    config.maxDashboardFields = 8;
+
+    expect(checkDashboardFieldsLimit(dashboard)).toEqual({ limit: 8, count: 8, exceedsLimit: false });
+  });
+
+  it('exceeds the limit with more fields than the limit', () => {
+    config.maxDashboardFields = 5;
+
+    expect(checkDashboardFieldsLimit(dashboard)).toEqual({ limit: 5, count: 8, exceedsLimit: true });
+  });
+});
@@ -0,0 +1,28 @@
+import { config } from '@grafana/runtime';
+
+/**
+ * Counts the fields of a dashboard JSON model, including the fields of nested objects such as panels and targets.
+ */
+export function countDashboardFields(value: unknown): number {
+  if (Array.isArray(value)) {
+    return value.reduce<number>((count, item) => count + countDashboardFields(item), 0);
+  }
+
+  if (value !== null && typeof value === 'object') {
+    return Object.values(value).reduce<number>((count, item) => count + 1 + countDashboardFields(item), 0);
+  }
+
+  return 0;
+}
+
+export function checkDashboardFieldsLimit(dashboard: unknown) {
+  const limit = config.maxDashboardFields;
+
+  // 0 means there is no configured limit, skip walking the whole model
+  if (limit <= 0) {
+    return { limit, count: 0, exceedsLimit: false };
+  }
+
+  const count = countDashboardFields(dashboard);
+  return { limit, count, exceedsLimit: count > limit };
+}
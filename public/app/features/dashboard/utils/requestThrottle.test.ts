@@ -0,0 +1,32 @@
+import { RequestThrottle } from './requestThrottle';
+
+describe('RequestThrottle', () => {
+  let now = 0;
+  const clock = () => now;
+
+  beforeEach(() => {
+    now = 0;
+  });
+
+  it('should never throttle when unlimited', () => {
+    const throttle = new RequestThrottle(0, clock);
+
+    for (let i = 0; i < 100; i++) {
+      expect(throttle.acquire()).toBe(0);
+    }
+  });
+
+  it('should throttle requests over the limit until the minute has passed', () => {
+    const throttle = new RequestThrottle(2, clock);
+
+    expect(throttle.acquire()).toBe(0);
+    now = 10000;
+    expect(throttle.acquire()).toBe(0);
+    now = 20000;
+    expect(throttle.acquire()).toBe(40000);
+
+    now = 60000;
+    expect(throttle.acquire()).toBe(0);
+    expect(throttle.acquire()).toBe(10000);
+  });
+});
@@ -0,0 +1,33 @@
+const WINDOW_MS = 60 * 1000;
+
+/**
+ * Limits how many requests are sent per minute, a limit of 0 or less means unlimited.
+ */
+export class RequestThrottle {
+  private sent: number[] = [];
+
+  constructor(
+    private maxPerMinute: number,
+    private now: () => number = Date.now
+  ) {}
+
+  /**
+   * Records a request when it can be sent right away and returns 0,
+   * otherwise returns the number of milliseconds until it can be sent.
+   */
+  acquire(): number {
+    if (this.maxPerMinute <= 0) {
+      return 0;
+    }
+
+    const now = this.now();
+    this.sent = this.sent.filter((time) => now - time < WINDOW_MS);
+
+    if (this.sent.length >= this.maxPerMinute) {
+      return this.sent[0] + WINDOW_MS - now;
+    }
+
+    this.sent.push(now);
+    return 0;
+  }
+}
@@ -0,0 +1,56 @@
+import { screen, render } from '@testing-library/react';
+import React from 'react';
+
+import { DataSourceInstanceSettings } from '@grafana/data';
+import { config } from '@grafana/runtime';
+
+import { getMockDataSourceMeta } from '../__mocks__';
+
+import { DataSourceTypeCard } from './DataSourceTypeCard';
+
+const setup = () =>
+  render(
+    <DataSourceTypeCard
+      dataSourcePlugin={getMockDataSourceMeta({ id: 'prometheus', name: 'Prometheus' })}
+      onClick={jest.fn()}
+    />
+  );
+
+describe('<DataSourceTypeCard>', () => {
+  const originalMaxDatasourceInstancesPerPlugin = config.maxDatasourceInstancesPerPlugin;
+  const originalDatasources = config.datasources;
+
+  beforeEach(() => {
+    config.datasources = {
+      'prometheus-1': { name: 'prometheus-1', uid: 'prometheus-1', type: 'prometheus' } as DataSourceInstanceSettings,
+      'prometheus-2': { name: 'prometheus-2', uid: 'prometheus-2', type: 'prometheus' } as DataSourceInstanceSettings,
+    };
+  });
+
+  afterEach(() => {
+    config.maxDatasourceInstancesPerPlugin = originalMaxDatasourceInstancesPerPlugin;
+    config.datasources = originalDatasources;
+  });
+
+  it('should not warn about the number of instances when there is no limit', () => {
+    config.maxDatasourceInstancesPerPlugin = 0;
+    setup();
+
+    expect(screen.getByText('Prometheus')).toBeInTheDocument();
+    expect(screen.queryByText('2 instances')).not.toBeInTheDocument();
+  });
+
+  it('should not warn about the number of instances below the limit', () => {
+    config.maxDatasourceInstancesPerPlugin = 3;
+    setup();
+
+    expect(screen.queryByText('2 instances')).not.toBeInTheDocument();
+  });
+
+  it('should warn about the number of instances when the plugin reaches the limit', () => {
+    config.maxDatasourceInstancesPerPlugin = 2;
+    setup();
+
+    expect(screen.getByText('2 instances')).toBeInTheDocument();
+  });
+});
@@ -3,7 +3,9 @@ import React from 'react';
 
 import { DataSourcePluginMeta, GrafanaTheme2 } from '@grafana/data';
 import { selectors as e2eSelectors } from '@grafana/e2e-selectors';
-import { Card, LinkButton, PluginSignatureBadge, useStyles2 } from '@grafana/ui';
+import { Badge, Card, LinkButton, PluginSignatureBadge, useStyles2 } from '@grafana/ui';
+
+import { checkDataSourceInstancesLimit } from '../utils';
 
 export type Props = {
   dataSourcePlugin: DataSourcePluginMeta;
@@ -15,6 +17,7 @@ export function DataSourceTypeCard({ onClick, dataSourcePlugin }: Props) {
   const isClickable = !isPhantom && !dataSourcePlugin.unlicensed;
   const learnMoreLink = dataSourcePlugin.info?.links?.length > 0 ? dataSourcePlugin.info.links[0] : null;
   const learnMoreLinkTarget = learnMoreLink?.target ?? '_blank';
+  const instancesLimit = checkDataSourceInstancesLimit(dataSourcePlugin.id);
 
   const styles = useStyles2(getStyles);
 
@@ -39,6 +42,14 @@ export function DataSourceTypeCard({ onClick, dataSourcePlugin }: Props) {
       {!isPhantom && (
         <Card.Meta className={styles.meta}>
           <PluginSignatureBadge status={dataSourcePlugin.signature} />
+          {instancesLimit.reachesLimit && (
+            <Badge
+              color="orange"
+              icon="exclamation-triangle"
+              text={`${instancesLimit.count} instances`}
+              tooltip={`There are already ${instancesLimit.count} data sources of this type, the configured limit is ${instancesLimit.limit}. Many instances of one plugin slow down the data source picker.`}
+            />
+          )}
         </Card.Meta>
       )}
 
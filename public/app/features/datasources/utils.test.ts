@@ -1,6 +1,8 @@
+import { DataSourceInstanceSettings } from '@grafana/data';
 import { getMockPlugin, getMockPlugins } from '@grafana/data/test/__mocks__/pluginMocks';
+import { config } from '@grafana/runtime';
 
-import { nameExits, findNewName } from './utils';
+import { nameExits, findNewName, checkDataSourceInstancesLimit } from './utils';
 
 describe('Datasources / Utils', () => {
   describe('nameExists()', () => {
@@ -38,4 +40,41 @@ describe('Datasources / Utils', () => {
       expect(findNewName(plugins, name)).toEqual('pretty cool plugin-');
     });
   });
+
+  describe('checkDataSourceInstancesLimit()', () => {
+    const originalMaxDatasourceInstancesPerPlugin = config.maxDatasourceInstancesPerPlugin;
+    const originalDatasources = config.datasources;
+
+    beforeEach(() => {
+      const instance = (name: string, type: string) => ({ name, uid: name, type }) as DataSourceInstanceSettings;
+      config.datasources = {
+        'prometheus-1': instance('prometheus-1', 'prometheus'),
+        'prometheus-2': instance('prometheus-2', 'prometheus'),
+        loki: instance('loki', 'loki'),
+      };
+    });
+
+    afterEach(() => {
+      config.maxDatasourceInstancesPerPlugin = originalMaxDatasourceInstancesPerPlugin;
+      config.datasources = originalDatasources;
+    });
+
+    it('should not reach the limit when there is no limit', () => {
+      config.maxDatasourceInstancesPerPlugin = 0;
+
+      expect(checkDataSourceInstancesLimit('prometheus')).toEqual({ limit: 0, count: 2, reachesLimit: false });
+    });
+
+    it('should reach the limit when the plugin has as many instances as the limit', () => {
+      config.maxDatasourceInstancesPerPlugin = 2;
+
+      expect(checkDataSourceInstancesLimit('prometheus')).toEqual({ limit: 2, count: 2, reachesLimit: true });
+    });
+
+    it('should only count the instances of the plugin', () => {
+      config.maxDatasourceInstancesPerPlugin = 2;
+
+      expect(checkDataSourceInstancesLimit('loki')).toEqual({ limit: 2, count: 1, reachesLimit: false });
+    });
+  });
 });
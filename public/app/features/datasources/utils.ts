@@ -1,4 +1,5 @@
 import { DataSourceJsonData, DataSourceSettings, urlUtil, locationUtil } from '@grafana/data';
+import { config } from '@grafana/runtime';
 
 interface ItemWithName {
   name: string;
@@ -54,3 +55,11 @@ export const constructDataSourceExploreUrl = (dataSource: DataSourceSettings<Dat
 
   return exploreUrl;
 };
+
+export function checkDataSourceInstancesLimit(pluginId: string) {
+  // 0 means there is no configured limit
+  const limit = config.maxDatasourceInstancesPerPlugin;
+  const count = Object.values(config.datasources).filter((dataSource) => dataSource.type === pluginId).length;
+
+  return { limit, count, reachesLimit: limit > 0 && count >= limit };
+}
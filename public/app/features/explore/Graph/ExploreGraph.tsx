@@ -35,6 +35,7 @@ import { seriesVisibilityConfigFactory } from '../../dashboard/dashgrid/SeriesVi
 import { useExploreDataLinkPostProcessor } from '../hooks/useExploreDataLinkPostProcessor';
 
 import { applyGraphStyle, applyThresholdsConfig } from './exploreGraphStyleUtils';
+import { copyTimeRange, createOrgAnnotation, getRangeSelectAction } from './rangeSelect';
 import { useStructureRev } from './useStructureRev';
 
 interface Props {
@@ -160,6 +161,8 @@ export function ExploreGraph({
     }
   }, [dataWithConfig, onHiddenSeriesChanged]);
 
+  const rangeSelectAction = getRangeSelectAction();
+
   const panelContext: PanelContext = {
     eventsScope: 'explore',
     eventBus,
@@ -170,6 +173,12 @@ export function ExploreGraph({
     dataLinkPostProcessor,
   };
 
+  if (rangeSelectAction === 'annotate') {
+    panelContext.canAddAnnotations = () => true;
+    panelContext.annotateOnSelect = true;
+    panelContext.onAnnotationCreate = createOrgAnnotation;
+  }
+
   const panelOptions: TimeSeriesOptions = useMemo(
     () => ({
       tooltip: { mode: tooltipDisplayMode, sort: SortOrder.None },
@@ -197,7 +206,7 @@ export function ExploreGraph({
         title=""
         width={width}
         height={height}
-        onChangeTimeRange={onChangeTime}
+        onChangeTimeRange={rangeSelectAction === 'copy' ? copyTimeRange : onChangeTime}
         timeZone={timeZone}
         options={panelOptions}
       />
@@ -0,0 +1,69 @@
+import { config } from '@grafana/runtime';
+import { contextSrv } from 'app/core/services/context_srv';
+import * as exploreUtils from 'app/core/utils/explore';
+import * as annotationsApi from 'app/features/annotations/api';
+
+import { copyTimeRange, createOrgAnnotation, getRangeSelectAction } from './rangeSelect';
+
+jest.mock('app/store/store', () => ({
+  dispatch: jest.fn(),
+}));
+
+describe('rangeSelect', () => {
+  const originalAction = config.defaultExploreRangeSelectAction;
+
+  afterEach(() => {
+    config.defaultExploreRangeSelectAction = originalAction;
+    jest.restoreAllMocks();
+  });
+
+  describe('getRangeSelectAction', () => {
+    it('should zoom by default', () => {
+      config.defaultExploreRangeSelectAction = '';
+
+      expect(getRangeSelectAction()).toBe('zoom');
+    });
+
+    it('should use the configured action', () => {
+      config.defaultExploreRangeSelectAction = 'copy';
+
+      expect(getRangeSelectAction()).toBe('copy');
+    });
+
+    it('should annotate when the user can create annotations', () => {
+      config.defaultExploreRangeSelectAction = 'annotate';
+      jest.spyOn(contextSrv, 'hasPermission').mockReturnValue(true);
+
+      expect(getRangeSelectAction()).toBe('annotate');
+    });
+
+    it('should zoom when the user cannot create annotations', () => {
+      config.defaultExploreRangeSelectAction = 'annotate';
+      jest.spyOn(contextSrv, 'hasPermission').mockReturnValue(false);
+
+      expect(getRangeSelectAction()).toBe('zoom');
+    });
+  });
+
+  it('should copy the selected range to the clipboard', () => {
+    const copy = jest.spyOn(exploreUtils, 'copyStringToClipboard').mockImplementation(() => {});
+
+    copyTimeRange({ from: 0, to: 60000 });
+
+    expect(copy).toHaveBeenCalledWith('{"from":"1970-01-01T00:00:00.000Z","to":"1970-01-01T00:01:00.000Z"}');
+  });
+
+  it('should save the selected range as an organization annotation', async () => {
+    const save = jest.spyOn(annotationsApi, 'saveAnnotation').mockResolvedValue({});
+
+    await createOrgAnnotation({ from: 1000, to: 2000, tags: ['deploy'], description: 'Deployed' });
+
+    expect(save).toHaveBeenCalledWith({
+      isRegion: true,
+      time: 1000,
+      timeEnd: 2000,
+      tags: ['deploy'],
+      text: 'Deployed',
+    });
+  });
+});
@@ -0,0 +1,47 @@
+import { AbsoluteTimeRange, AnnotationEventUIModel } from '@grafana/data';
+import { config } from '@grafana/runtime';
+import { notifyApp } from 'app/core/actions';
+import { createSuccessNotification } from 'app/core/copy/appNotification';
+import { t } from 'app/core/internationalization';
+import { contextSrv } from 'app/core/services/context_srv';
+import { copyStringToClipboard } from 'app/core/utils/explore';
+import { saveAnnotation } from 'app/features/annotations/api';
+import { dispatch } from 'app/store/store';
+import { AccessControlAction } from 'app/types';
+
+export type RangeSelectAction = 'zoom' | 'annotate' | 'copy';
+
+/**
+ * Returns what happens when a time range is selected in the graph, zooming in unless configured otherwise
+ */
+export const getRangeSelectAction = (): RangeSelectAction => {
+  const action = config.defaultExploreRangeSelectAction;
+  if (action === 'copy') {
+    return action;
+  }
+  // Without the permission the selection falls back to zooming
+  if (action === 'annotate' && contextSrv.hasPermission(AccessControlAction.AnnotationsCreate)) {
+    return action;
+  }
+  return 'zoom';
+};
+
+export const copyTimeRange = (range: AbsoluteTimeRange) => {
+  copyStringToClipboard(
+    JSON.stringify({ from: new Date(range.from).toISOString(), to: new Date(range.to).toISOString() })
+  );
+  dispatch(notifyApp(createSuccessNotification(t('explore.graph.time-range-copied', 'Time range copied to clipboard'))));
+};
+
+// Explore isn't part of a dashboard, so annotations are added to the organization
+export const createOrgAnnotation = async (event: AnnotationEventUIModel) => {
+  const isRegion = event.from !== event.to;
+  await saveAnnotation({
+    isRegion,
+    time: event.from,
+    timeEnd: isRegion ? event.to : 0,
+    tags: event.tags,
+    text: event.description,
+  });
+  dispatch(notifyApp(createSuccessNotification(t('explore.graph.annotation-added', 'Annotation added'))));
+};
@@ -15,6 +15,7 @@ import {
   RawTimeRange,
   SupplementaryQueryType,
 } from '@grafana/data';
+import { config } from '@grafana/runtime';
 import { DataQuery, DataSourceRef } from '@grafana/schema';
 import { createAsyncThunk, ExploreItemState, StoreState, ThunkDispatch } from 'app/types';
 
@@ -181,6 +182,50 @@ describe('runQueries', () => {
     }
   });
 
+  describe('with row limits by data source type', () => {
+    const originalExploreRowLimitByType = config.exploreRowLimitByType;
+
+    const setupTestsWithType = (type: string) => {
+      setTimeSrv({ init() {} } as unknown as TimeSrv);
+      const leftPane = defaultInitialState.explore.panes.left;
+      return configureStore({
+        ...defaultInitialState,
+        explore: { panes: { left: { ...leftPane, datasourceInstance: { ...leftPane.datasourceInstance, type } } } },
+      } as unknown as Partial<StoreState>);
+    };
+
+    beforeEach(() => {
+      config.exploreRowLimitByType = { loki: 500 };
+    });
+
+    afterEach(() => {
+      config.exploreRowLimitByType = originalExploreRowLimitByType;
+    });
+
+    it('should apply the configured row limit of the data source type to the queries', async () => {
+      const { dispatch, getState } = setupTestsWithType('loki');
+      setupQueryResponse(getState());
+      await dispatch(saveCorrelationsAction({ exploreId: 'left', correlations: [] }));
+      await dispatch(runQueries({ exploreId: 'left' }));
+
+      const datasourceInstance = getState().explore.panes.left!.datasourceInstance!;
+      expect(datasourceInstance.query).toHaveBeenLastCalledWith(
+        expect.objectContaining({ maxDataPoints: 1920, targets: [expect.objectContaining({ maxLines: 500 })] })
+      );
+    });
+
+    it('should not change the queries of other data source types', async () => {
+      const { dispatch, getState } = setupTestsWithType('prometheus');
+      setupQueryResponse(getState());
+      await dispatch(saveCorrelationsAction({ exploreId: 'left', correlations: [] }));
+      await dispatch(runQueries({ exploreId: 'left' }));
+
+      const datasourceInstance = getState().explore.panes.left!.datasourceInstance!;
+      const request = jest.mocked(datasourceInstance.query).mock.lastCall![0];
+      expect(request.targets[0]).not.toHaveProperty('maxLines');
+    });
+  });
+
   it('should set state to done if query completes without emitting', async () => {
     const { dispatch, getState } = setupTests();
     const leftDatasourceInstance = assertIsDefined(getState().explore.panes.left!.datasourceInstance);
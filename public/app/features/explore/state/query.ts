@@ -52,6 +52,7 @@ import { notifyApp } from '../../../core/actions';
 import { createErrorNotification } from '../../../core/copy/appNotification';
 import { runRequest } from '../../query/state/runRequest';
 import { decorateData } from '../utils/decorators';
+import { withRowLimits } from '../utils/queries';
 import {
   getSupplementaryQueryProvider,
   storeSupplementaryQueryEnabled,
@@ -599,7 +600,7 @@ export const runQueries = createAsyncThunk<void, RunQueriesOptions>(
       const timeZone = getTimeZone(getState().user);
       const transaction = buildQueryTransaction(
         exploreId,
-        queries,
+        withRowLimits(queries, datasourceInstance.type),
         queryOptions,
         range,
         scanning,
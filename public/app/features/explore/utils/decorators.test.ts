@@ -10,6 +10,7 @@ import {
   DataSourceApi,
   DataSourceInstanceSettings,
 } from '@grafana/data';
+import { config } from '@grafana/runtime';
 import { DataSourceJsonData, DataQuery } from '@grafana/schema';
 import TableModel from 'app/core/TableModel';
 import { CorrelationData } from 'app/features/correlations/useCorrelations';
@@ -190,6 +191,51 @@ describe('decorateWithGraphLogsTraceTableAndFlameGraph', () => {
     });
   });
 
+  describe('when a visualisation is configured for the data source type', () => {
+    const originalVizByType = config.defaultExploreVizByDatasourceType;
+
+    afterEach(() => {
+      config.defaultExploreVizByDatasourceType = originalVizByType;
+    });
+
+    it('should use it for frames without a preferred visualisation', () => {
+      config.defaultExploreVizByDatasourceType = { loki: 'logs' };
+      const { timeSeries, table } = getTestContext();
+      const panelData: PanelData = {
+        series: [timeSeries, table],
+        state: LoadingState.Done,
+        timeRange: getDefaultTimeRange(),
+        request: {
+          targets: [{ refId: 'A', datasource: { uid: 'loki-uid', type: 'loki' } }],
+        } as PanelData['request'],
+      };
+
+      const result = decorateWithFrameTypeMetadata(panelData);
+
+      expect(result.graphFrames).toEqual([timeSeries]);
+      expect(result.logsFrames).toEqual([table]);
+      expect(result.tableFrames).toEqual([]);
+    });
+
+    it('should guess the visualisation for other data source types', () => {
+      config.defaultExploreVizByDatasourceType = { loki: 'logs' };
+      const { table } = getTestContext();
+      const panelData: PanelData = {
+        series: [table],
+        state: LoadingState.Done,
+        timeRange: getDefaultTimeRange(),
+        request: {
+          targets: [{ refId: 'A', datasource: { uid: 'prom-uid', type: 'prometheus' } }],
+        } as PanelData['request'],
+      };
+
+      const result = decorateWithFrameTypeMetadata(panelData);
+
+      expect(result.logsFrames).toEqual([]);
+      expect(result.tableFrames).toEqual([table]);
+    });
+  });
+
   it('should return frames even if there is an error', () => {
     const { timeSeries, logs, table } = getTestContext();
     const series: DataFrame[] = [timeSeries, logs, table];
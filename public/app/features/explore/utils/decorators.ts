@@ -13,8 +13,9 @@ import {
   DataLinkConfigOrigin,
   getRawDisplayProcessor,
   DataSourceApi,
+  PreferredVisualisationType,
 } from '@grafana/data';
-import { config } from '@grafana/runtime';
+import { config, getDataSourceSrv } from '@grafana/runtime';
 import { DataQuery } from '@grafana/schema';
 
 import { refreshIntervalToSortOrder } from '../../../core/utils/explore';
@@ -45,7 +46,7 @@ export const decorateWithFrameTypeMetadata = (data: PanelData): ExplorePanelData
       customFrames.push(frame);
       continue;
     }
-    switch (frame.meta?.preferredVisualisationType) {
+    switch (frame.meta?.preferredVisualisationType ?? getConfiguredVisualisationType(frame, data)) {
       case 'logs':
         logsFrames.push(frame);
         break;
@@ -95,6 +96,24 @@ export const decorateWithFrameTypeMetadata = (data: PanelData): ExplorePanelData
   };
 };
 
+/**
+ * Returns the visualisation configured for the data source type of the query that returned the frame, if any.
+ */
+const getConfiguredVisualisationType = (frame: DataFrame, data: PanelData): PreferredVisualisationType | undefined => {
+  const vizByType = config.defaultExploreVizByDatasourceType;
+  if (!vizByType || Object.keys(vizByType).length === 0) {
+    return undefined;
+  }
+
+  const datasource = data.request?.targets.find((target) => target.refId === frame.refId)?.datasource;
+  if (!datasource) {
+    return undefined;
+  }
+
+  const type = datasource.type ?? getDataSourceSrv().getInstanceSettings(datasource)?.type;
+  return type ? vizByType[type] : undefined;
+};
+
 export const decorateWithCorrelations = ({
   showCorrelationEditorLinks,
   queries,
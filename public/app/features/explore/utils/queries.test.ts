@@ -0,0 +1,53 @@
+import { DataQuery } from '@grafana/schema';
+
+import { withRowLimits } from './queries';
+
+const rowLimitByType = { loki: 500, elasticsearch: 1000 };
+
+describe('withRowLimits', () => {
+  it('should set the line limit of Loki queries', () => {
+    const queries: DataQuery[] = [{ refId: 'A', expr: '{job="app"}' } as DataQuery];
+
+    expect(withRowLimits(queries, 'loki', rowLimitByType)).toEqual([
+      { refId: 'A', expr: '{job="app"}', maxLines: 500 },
+    ]);
+  });
+
+  it('should keep the line limit of Loki queries that set one', () => {
+    const queries: DataQuery[] = [{ refId: 'A', expr: '{job="app"}', maxLines: 10 } as DataQuery];
+
+    expect(withRowLimits(queries, 'loki', rowLimitByType)).toEqual(queries);
+  });
+
+  it('should set the size of Elasticsearch raw data queries and the limit of logs queries', () => {
+    const queries: DataQuery[] = [
+      { refId: 'A', metrics: [{ id: '1', type: 'raw_data' }] } as DataQuery,
+      { refId: 'B', metrics: [{ id: '1', type: 'logs' }] } as DataQuery,
+      { refId: 'C', metrics: [{ id: '1', type: 'count' }] } as DataQuery,
+    ];
+
+    expect(withRowLimits(queries, 'elasticsearch', rowLimitByType)).toEqual([
+      { refId: 'A', metrics: [{ id: '1', type: 'raw_data', settings: { size: '1000' } }] },
+      { refId: 'B', metrics: [{ id: '1', type: 'logs', settings: { limit: '1000' } }] },
+      { refId: 'C', metrics: [{ id: '1', type: 'count' }] },
+    ]);
+  });
+
+  it('should use the data source type of each query', () => {
+    const queries: DataQuery[] = [
+      { refId: 'A', datasource: { type: 'loki', uid: 'loki' }, expr: '{job="app"}' } as DataQuery,
+      { refId: 'B', datasource: { type: 'prometheus', uid: 'prom' }, expr: 'up' } as DataQuery,
+    ];
+
+    expect(withRowLimits(queries, 'mixed', rowLimitByType)).toEqual([
+      { refId: 'A', datasource: { type: 'loki', uid: 'loki' }, expr: '{job="app"}', maxLines: 500 },
+      { refId: 'B', datasource: { type: 'prometheus', uid: 'prom' }, expr: 'up' },
+    ]);
+  });
+
+  it('should not change the queries without configured limits', () => {
+    const queries: DataQuery[] = [{ refId: 'A', expr: '{job="app"}' } as DataQuery];
+
+    expect(withRowLimits(queries, 'loki', {})).toEqual(queries);
+  });
+});
@@ -1,5 +1,8 @@
+import { config } from '@grafana/runtime';
 import { DataQuery } from '@grafana/schema';
 import { getNextRefIdChar } from 'app/core/utils/query';
+import { ElasticsearchQuery, MetricAggregation } from 'app/plugins/datasource/elasticsearch/types';
+import { LokiQuery } from 'app/plugins/datasource/loki/types';
 
 /**
  * Makes sure all the queries have unique (and valid) refIds
@@ -30,3 +33,48 @@ export function withUniqueRefIds(queries: DataQuery[]): DataQuery[] {
     return newQuery;
   });
 }
+
+/**
+ * Applies the configured row limit of their data source type to queries that don't set a limit themselves.
+ * Row limits are supported by Loki (line limit) and Elasticsearch (size of raw data and limit of logs queries).
+ */
+export function withRowLimits(
+  queries: DataQuery[],
+  datasourceType: string,
+  rowLimitByType: Record<string, number> = config.exploreRowLimitByType
+): DataQuery[] {
+  return queries.map((query) => {
+    const type = query.datasource?.type ?? datasourceType;
+    const limit = rowLimitByType?.[type];
+    if (!limit) {
+      return query;
+    }
+
+    if (type === 'loki') {
+      const lokiQuery = query as LokiQuery;
+      return lokiQuery.maxLines ? lokiQuery : { ...lokiQuery, maxLines: limit };
+    }
+
+    if (type === 'elasticsearch') {
+      const elasticsearchQuery = query as ElasticsearchQuery;
+      return {
+        ...elasticsearchQuery,
+        metrics: elasticsearchQuery.metrics?.map((metric) => withElasticsearchRowLimit(metric, limit)),
+      };
+    }
+
+    return query;
+  });
+}
+
+function withElasticsearchRowLimit(metric: MetricAggregation, limit: number): MetricAggregation {
+  switch (metric.type) {
+    case 'raw_data':
+    case 'raw_document':
+      return metric.settings?.size ? metric : { ...metric, settings: { ...metric.settings, size: `${limit}` } };
+    case 'logs':
+      return metric.settings?.limit ? metric : { ...metric, settings: { ...metric.settings, limit: `${limit}` } };
+    default:
+      return metric;
+  }
+}
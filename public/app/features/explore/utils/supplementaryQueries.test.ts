@@ -16,15 +16,20 @@ import {
   SupplementaryQueryOptions,
   toDataFrame,
 } from '@grafana/data';
-import { getDataSourceSrv } from '@grafana/runtime';
+import { config, getDataSourceSrv } from '@grafana/runtime';
 import { DataQuery } from '@grafana/schema';
+import store from 'app/core/store';
 
 import { MockDataSourceApi } from '../../../../test/mocks/datasource_srv';
 import { MockDataQueryRequest, MockQuery } from '../../../../test/mocks/query';
 import { ExplorePanelData } from '../../../types';
 import { mockExplorePanelData } from '../__mocks__/data';
 
-import { getSupplementaryQueryProvider } from './supplementaryQueries';
+import {
+  getSupplementaryQueryProvider,
+  loadSupplementaryQueries,
+  storeSupplementaryQueryEnabled,
+} from './supplementaryQueries';
 
 class MockDataSourceWithSupplementaryQuerySupport
   extends MockDataSourceApi
@@ -359,3 +364,29 @@ describe('SupplementaryQueries utils', function () {
     });
   });
 });
+
+describe('loadSupplementaryQueries', () => {
+  const originalDefaultExploreSupplementaryQueries = config.defaultExploreSupplementaryQueries;
+
+  afterEach(() => {
+    config.defaultExploreSupplementaryQueries = originalDefaultExploreSupplementaryQueries;
+    store.delete(`grafana.explore.logs.enable${SupplementaryQueryType.LogsVolume}`);
+  });
+
+  it('enables logs volume by default', () => {
+    expect(loadSupplementaryQueries()[SupplementaryQueryType.LogsVolume]).toEqual({ enabled: true });
+  });
+
+  it('disables logs volume when configured off', () => {
+    config.defaultExploreSupplementaryQueries = false;
+
+    expect(loadSupplementaryQueries()[SupplementaryQueryType.LogsVolume]).toEqual({ enabled: false });
+  });
+
+  it('keeps the choice of the user over the configured default', () => {
+    config.defaultExploreSupplementaryQueries = false;
+    storeSupplementaryQueryEnabled(true, SupplementaryQueryType.LogsVolume);
+
+    expect(loadSupplementaryQueries()[SupplementaryQueryType.LogsVolume]).toEqual({ enabled: true });
+  });
+});
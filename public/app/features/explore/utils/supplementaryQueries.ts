@@ -15,6 +15,7 @@ import {
   LogsVolumeType,
   SupplementaryQueryType,
 } from '@grafana/data';
+import { config } from '@grafana/runtime';
 import store from 'app/core/store';
 import { ExplorePanelData, SupplementaryQueries } from 'app/types';
 
@@ -32,9 +33,9 @@ export const storeSupplementaryQueryEnabled = (enabled: boolean, type: Supplemen
 };
 
 export const loadSupplementaryQueries = (): SupplementaryQueries => {
-  // We default to true for all supp queries
+  // We default to the configured default for all supp queries
   let supplementaryQueries: SupplementaryQueries = {
-    [SupplementaryQueryType.LogsVolume]: { enabled: true },
+    [SupplementaryQueryType.LogsVolume]: { enabled: config.defaultExploreSupplementaryQueries },
     [SupplementaryQueryType.LogsSample]: { enabled: false },
   };
 
@@ -44,10 +45,12 @@ export const loadSupplementaryQueries = (): SupplementaryQueries => {
       continue;
     }
 
-    // Only if "false" value in local storage, we disable it
+    // A value in local storage means the user toggled it, which overrides the configured default
     const shouldBeEnabled = store.get(getSupplementaryQuerySettingKey(type));
     if (shouldBeEnabled === 'false') {
       supplementaryQueries[type] = { enabled: false };
+    } else if (shouldBeEnabled === 'true') {
+      supplementaryQueries[type] = { enabled: true };
     }
   }
   return supplementaryQueries;
@@ -48,7 +48,7 @@ class UnThemedLogDetails extends PureComponent<Props> {
       wrapLogMessage,
       styles,
     } = this.props;
-    const levelStyles = getLogLevelStyles(theme, row.logLevel);
+    const levelStyles = getLogLevelStyles(theme, row.logLevel, row.labels?.level);
     const labels = row.labels ? row.labels : {};
     const labelsAvailable = Object.keys(labels).length > 0;
     const fieldsAndLinks = getAllFields(row, getFieldLinks);
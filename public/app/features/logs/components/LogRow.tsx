@@ -187,7 +187,7 @@ class UnThemedLogRow extends PureComponent<Props, State> {
       styles,
     } = this.props;
     const { showDetails, showingContext, permalinked } = this.state;
-    const levelStyles = getLogLevelStyles(theme, row.logLevel);
+    const levelStyles = getLogLevelStyles(theme, row.logLevel, row.labels?.level);
     const { errorMessage, hasError } = checkLogsError(row);
     const logRowBackground = cx(styles.logsRow, {
       [styles.errorLogRow]: hasError,
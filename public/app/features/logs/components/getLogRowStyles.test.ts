@@ -0,0 +1,30 @@
+import { createTheme, LogLevel } from '@grafana/data';
+import { config } from '@grafana/runtime';
+
+import { getLogLevelColor } from './getLogRowStyles';
+
+describe('getLogLevelColor', () => {
+  const theme = createTheme();
+  const logLevelColorMap = config.logLevelColorMap;
+
+  afterEach(() => {
+    config.logLevelColorMap = logLevelColorMap;
+  });
+
+  it('uses the built-in colors when no log level colors are configured', () => {
+    config.logLevelColorMap = {};
+    expect(getLogLevelColor(theme, LogLevel.error)).toBe('#e24d42');
+    expect(getLogLevelColor(theme, LogLevel.unknown, 'notice')).toBe(theme.v1.palette.gray2);
+  });
+
+  it('colors custom log levels per config', () => {
+    config.logLevelColorMap = { notice: 'blue', audit: '#8f3bb8' };
+    expect(getLogLevelColor(theme, LogLevel.unknown, 'NOTICE')).toBe(theme.visualization.getColorByName('blue'));
+    expect(getLogLevelColor(theme, LogLevel.unknown, 'audit')).toBe('#8f3bb8');
+  });
+
+  it('keeps the built-in colors for levels that are not configured', () => {
+    config.logLevelColorMap = { notice: 'blue' };
+    expect(getLogLevelColor(theme, LogLevel.info, 'info')).toBe('#7eb26d');
+  });
+});
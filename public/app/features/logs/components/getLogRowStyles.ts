@@ -6,7 +6,16 @@ import { colorManipulator, GrafanaTheme2, LogLevel } from '@grafana/data';
 import { config } from '@grafana/runtime';
 import { styleMixins } from '@grafana/ui';
 
-export const getLogLevelStyles = (theme: GrafanaTheme2, logLevel?: LogLevel) => {
+/**
+ * Returns the color a log level is shown with. Levels named in the configured log level colors,
+ * such as custom levels the built-in levels don't cover, use the configured color.
+ */
+export const getLogLevelColor = (theme: GrafanaTheme2, logLevel?: LogLevel, levelName?: string) => {
+  const configuredColor = config.logLevelColorMap?.[(levelName ?? logLevel ?? '').toLowerCase()];
+  if (configuredColor) {
+    return theme.visualization.getColorByName(configuredColor);
+  }
+
   let logColor = theme.isLight ? theme.v1.palette.gray5 : theme.v1.palette.gray2;
   switch (logLevel) {
     case LogLevel.crit:
@@ -32,6 +41,12 @@ export const getLogLevelStyles = (theme: GrafanaTheme2, logLevel?: LogLevel) =>
       break;
   }
 
+  return logColor;
+};
+
+export const getLogLevelStyles = (theme: GrafanaTheme2, logLevel?: LogLevel, levelName?: string) => {
+  const logColor = getLogLevelColor(theme, logLevel, levelName);
+
   return {
     logsRowLevelColor: css`
       &::after {
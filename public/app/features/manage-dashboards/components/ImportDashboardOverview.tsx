@@ -4,6 +4,8 @@ import { connect, ConnectedProps } from 'react-redux';
 import { dateTimeFormat } from '@grafana/data';
 import { locationService, reportInteraction } from '@grafana/runtime';
 import { Form, Legend } from '@grafana/ui';
+import { DashboardFieldsLimitWarning } from 'app/features/dashboard/components/DashboardFieldsLimitWarning/DashboardFieldsLimitWarning';
+import { checkDashboardFieldsLimit } from 'app/features/dashboard/utils/dashboardFields';
 import { StoreState } from 'app/types';
 
 import { clearLoadedDashboard, importDashboard } from '../state/actions';
@@ -60,6 +62,7 @@ class ImportDashboardOverviewUnConnected extends PureComponent<Props, State> {
   render() {
     const { dashboard, inputs, meta, source, folder } = this.props;
     const { uidReset } = this.state;
+    const fieldsLimit = checkDashboardFieldsLimit(dashboard);
 
     return (
       <>
@@ -92,6 +95,9 @@ class ImportDashboardOverviewUnConnected extends PureComponent<Props, State> {
             </table>
           </div>
         )}
+        {fieldsLimit.exceedsLimit && (
+          <DashboardFieldsLimitWarning count={fieldsLimit.count} limit={fieldsLimit.limit} />
+        )}
         <Form
           onSubmit={this.onSubmit}
           defaultValues={{ ...dashboard, constants: [], dataSources: [], elements: [], folder: folder }}
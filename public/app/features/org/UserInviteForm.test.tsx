@@ -0,0 +1,40 @@
+import { render, screen } from '@testing-library/react';
+import React from 'react';
+import { TestProvider } from 'test/helpers/TestProvider';
+
+import { config } from '@grafana/runtime';
+
+import { UserInviteForm } from './UserInviteForm';
+
+describe('UserInviteForm', () => {
+  const originalSmtpEnabled = config.smtpEnabled;
+
+  afterEach(() => {
+    config.smtpEnabled = originalSmtpEnabled;
+  });
+
+  it('should offer to send an invite email when SMTP is configured', () => {
+    config.smtpEnabled = true;
+
+    render(
+      <TestProvider>
+        <UserInviteForm />
+      </TestProvider>
+    );
+
+    expect(screen.getByLabelText('Send invite email')).toBeChecked();
+  });
+
+  it('should explain that the invite is shared as a link when SMTP is not configured', () => {
+    config.smtpEnabled = false;
+
+    render(
+      <TestProvider>
+        <UserInviteForm />
+      </TestProvider>
+    );
+
+    expect(screen.queryByLabelText('Send invite email')).not.toBeInTheDocument();
+    expect(screen.getByText(/Copy the invite link from the pending invites/)).toBeInTheDocument();
+  });
+});
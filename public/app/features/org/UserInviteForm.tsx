@@ -17,6 +17,7 @@ import {
   Tooltip,
   Label,
   Stack,
+  Text,
 } from '@grafana/ui';
 import { getConfig } from 'app/core/config';
 import { OrgRole, useDispatch } from 'app/types';
@@ -51,12 +52,13 @@ export interface FormModel {
   email: string;
 }
 
-const defaultValues: FormModel = {
+const getDefaultValues = (): FormModel => ({
   name: '',
   email: '',
   role: OrgRole.Editor,
-  sendEmail: true,
-};
+  // without SMTP the invite can only be shared as a link
+  sendEmail: getConfig().smtpEnabled,
+});
 
 export const UserInviteForm = () => {
   const dispatch = useDispatch();
@@ -67,7 +69,7 @@ export const UserInviteForm = () => {
   };
 
   return (
-    <Form defaultValues={defaultValues} onSubmit={onSubmit}>
+    <Form defaultValues={getDefaultValues()} onSubmit={onSubmit}>
       {({ register, control, errors }) => {
         return (
           <>
@@ -103,9 +105,16 @@ export const UserInviteForm = () => {
                   name="role"
                 />
               </Field>
-              <Field label="Send invite email">
-                <Switch id="send-email-switch" {...register('sendEmail')} />
-              </Field>
+              {getConfig().smtpEnabled ? (
+                <Field label="Send invite email">
+                  <Switch id="send-email-switch" {...register('sendEmail')} />
+                </Field>
+              ) : (
+                <Text color="secondary" element="p">
+                  Email isn&apos;t configured, so no invite email is sent. Copy the invite link from the pending invites
+                  and share it with the user.
+                </Text>
+              )}
             </FieldSet>
             <Stack>
               <Button type="submit">Submit</Button>
@@ -2,7 +2,7 @@
 import { Store } from 'redux';
 import configureMockStore from 'redux-mock-store';
 
-import { locationService } from '@grafana/runtime';
+import { config, locationService } from '@grafana/runtime';
 import { setStore } from 'app/store/store';
 
 import { DashboardQueryResult } from '../search/service';
@@ -73,6 +73,7 @@ describe('PlaylistSrv', () => {
   let hrefMock: jest.Mock;
   let unmockLocation: () => void;
   const initialUrl = 'http://localhost/playlist';
+  const originalMaxDashboardRenderQueue = config.maxDashboardRenderQueue;
 
   beforeEach(() => {
     jest.clearAllMocks();
@@ -86,6 +87,7 @@ describe('PlaylistSrv', () => {
 
   afterEach(() => {
     unmockLocation();
+    config.maxDashboardRenderQueue = originalMaxDashboardRenderQueue;
   });
 
   it('runs all dashboards in cycle and reloads page after 3 cycles', async () => {
@@ -139,4 +141,21 @@ describe('PlaylistSrv', () => {
     expect((srv as any).validPlaylistUrl).toBe('/url/to/bbb');
     expect(srv.isPlaying).toBe(true);
   });
+
+  it('reloads the page after the configured number of dashboards and continues with the next one', async () => {
+    config.maxDashboardRenderQueue = 1;
+    await srv.start('foo');
+
+    srv.next();
+
+    expect(hrefMock).toHaveBeenLastCalledWith(initialUrl);
+
+    const reloadedSrv = createPlaylistSrv();
+    await reloadedSrv.start('foo');
+
+    // eslint-disable-next-line
+    expect((reloadedSrv as any).urls).toEqual(['/url/to/aaa', '/url/to/bbb']);
+    // eslint-disable-next-line
+    expect((reloadedSrv as any).validPlaylistUrl).toBe('/url/to/bbb');
+  });
 });
@@ -2,7 +2,8 @@ import { Location } from 'history';
 import { pickBy } from 'lodash';
 
 import { locationUtil, urlUtil, rangeUtil } from '@grafana/data';
-import { locationService } from '@grafana/runtime';
+import { config, locationService } from '@grafana/runtime';
+import store from 'app/core/store';
 
 import { getPlaylistAPI, loadDashboards } from './api';
 import { PlaylistAPI } from './types';
@@ -13,13 +14,17 @@ export const queryParamsToPreserve: { [key: string]: boolean } = {
   orgId: true,
 };
 
+// the dashboard a playlist continues with after reloading the page
+const PLAYLIST_RESUME_KEY = 'grafana.playlist.resume';
+
 export class PlaylistSrv {
   private nextTimeoutId: ReturnType<typeof setTimeout> | undefined;
   private urls: string[] = []; // the URLs we need to load
   private index = 0;
   private declare interval: number;
   private declare startUrl: string;
-  private numberOfLoops = 0;
+  private renderedDashboards = 0;
+  private declare playlistUid: string;
   private declare validPlaylistUrl: string;
   private locationListenerUnsub?: () => void;
   private api: PlaylistAPI;
@@ -36,17 +41,19 @@ export class PlaylistSrv {
 
     const playedAllDashboards = this.index > this.urls.length - 1;
     if (playedAllDashboards) {
-      this.numberOfLoops++;
-
-      // This does full reload of the playlist to keep memory in check due to existing leaks but at the same time
-      // we do not want page to flicker after each full loop.
-      if (this.numberOfLoops >= 3) {
-        window.location.href = this.startUrl;
-        return;
-      }
       this.index = 0;
     }
 
+    // This does full reload of the playlist to keep memory in check due to existing leaks but at the same time
+    // we do not want page to flicker after each dashboard.
+    if (this.renderedDashboards >= this.getMaxRenderedDashboards()) {
+      this.renderedDashboards = 0;
+      store.setObject(PLAYLIST_RESUME_KEY, { uid: this.playlistUid, index: this.index });
+      window.location.href = this.startUrl;
+      return;
+    }
+    this.renderedDashboards++;
+
     const url = this.urls[this.index];
     const queryParams = locationService.getSearchObject();
     const filteredParams = pickBy(queryParams, (value: unknown, key: string) => queryParamsToPreserve[key]);
@@ -59,6 +66,11 @@ export class PlaylistSrv {
     locationService.push(nextDashboardUrl + '?' + urlUtil.toUrlParams(filteredParams));
   }
 
+  // dashboards rendered before the page is reloaded, by default three loops of the playlist
+  private getMaxRenderedDashboards() {
+    return config.maxDashboardRenderQueue || 3 * this.urls.length;
+  }
+
   prev() {
     this.index = Math.max(this.index - 2, 0);
     this.next();
@@ -75,6 +87,7 @@ export class PlaylistSrv {
     this.stop();
 
     this.startUrl = window.location.href;
+    this.playlistUid = playlistUid;
     this.index = 0;
     this.isPlaying = true;
 
@@ -102,6 +115,13 @@ export class PlaylistSrv {
       // alert... not found, etc
       return;
     }
+
+    const resume = store.getObject<{ uid: string; index: number }>(PLAYLIST_RESUME_KEY);
+    store.delete(PLAYLIST_RESUME_KEY);
+    if (resume?.uid === playlistUid && resume.index < urls.length) {
+      this.index = resume.index;
+    }
+
     this.urls = urls;
     this.isPlaying = true;
     this.next();
@@ -2,7 +2,9 @@ import React from 'react';
 import { connect, ConnectedProps } from 'react-redux';
 import { useMount } from 'react-use';
 
+import { Alert } from '@grafana/ui';
 import { Page } from 'app/core/components/Page/Page';
+import { config } from 'app/core/config';
 import { StoreState } from 'app/types';
 
 import { ChangePasswordForm } from './ChangePasswordForm';
@@ -36,6 +38,9 @@ export function ChangePasswordPage({ loadUser, isUpdating, user, changePassword
       <Page.Contents isLoading={!Boolean(user)}>
         {user ? (
           <>
+            {config.mustChangePassword && (
+              <Alert severity="info" title="An administrator requires you to change your password" />
+            )}
             <ChangePasswordForm user={user} onChangePassword={changePassword} isSaving={isUpdating} />
           </>
         ) : null}
@@ -0,0 +1,28 @@
+import { locationService } from '@grafana/runtime';
+import { config } from 'app/core/config';
+
+import { redirectIfMustChangePassword } from './mustChangePassword';
+
+describe('redirectIfMustChangePassword', () => {
+  beforeEach(() => {
+    locationService.push('/d/abc/dashboard');
+  });
+
+  it('should send a user who must change their password to the change password page', () => {
+    redirectIfMustChangePassword({ ...config, mustChangePassword: true, profileEnabled: true });
+
+    expect(locationService.getLocation().pathname).toBe('/profile/password');
+  });
+
+  it('should leave a user who does not have to change their password where they are', () => {
+    redirectIfMustChangePassword({ ...config, mustChangePassword: false, profileEnabled: true });
+
+    expect(locationService.getLocation().pathname).toBe('/d/abc/dashboard');
+  });
+
+  it('should leave the user where they are when the profile is disabled', () => {
+    redirectIfMustChangePassword({ ...config, mustChangePassword: true, profileEnabled: false });
+
+    expect(locationService.getLocation().pathname).toBe('/d/abc/dashboard');
+  });
+});
@@ -0,0 +1,18 @@
+import { locationService } from '@grafana/runtime';
+import { config } from 'app/core/config';
+
+export const CHANGE_PASSWORD_PATH = '/profile/password';
+
+/**
+ * Sends users whose password was reset by an admin, with a change required on next login,
+ * to the change password page when Grafana loads.
+ */
+export function redirectIfMustChangePassword(cfg = config, location = locationService) {
+  if (!cfg.mustChangePassword || !cfg.profileEnabled) {
+    return;
+  }
+
+  if (location.getLocation().pathname !== CHANGE_PASSWORD_PATH) {
+    location.replace(CHANGE_PASSWORD_PATH);
+  }
+}
@@ -1,6 +1,7 @@
 import { render, screen } from '@testing-library/react';
 
 import { DataQueryRequest, dateTime, LoadingState, PanelData, toDataFrame } from '@grafana/data';
+import { config } from '@grafana/runtime';
 
 import { filterPanelDataToQuery, QueryEditorRow } from './QueryEditorRow';
 
@@ -218,4 +219,44 @@ describe('frame results with warnings', () => {
     const warningsComponent = editorRow.renderWarnings();
     expect(warningsComponent).toBe(null);
   });
+
+  describe('with a maximum number of variables per query', () => {
+    const originalMaxVariablesPerQuery = config.maxVariablesPerQuery;
+
+    afterEach(() => {
+      config.maxVariablesPerQuery = originalMaxVariablesPerQuery;
+    });
+
+    it('should show a warning badge when the query references too many variables', () => {
+      config.maxVariablesPerQuery = 1;
+      // @ts-ignore: there are _way_ too many props to inject here :(
+      const editorRow = new QueryEditorRow({
+        data: dataWithoutWarnings,
+        query: {
+          refId: 'B',
+          expr: 'up{job="$job", instance="$instance"}',
+        },
+      });
+
+      const warningsComponent = editorRow.renderWarnings();
+      expect(warningsComponent).not.toBe(null);
+
+      render(warningsComponent!);
+      expect(screen.getByText('1 warning')).toBeInTheDocument();
+    });
+
+    it('should not show a warning badge when the query is within the limit', () => {
+      config.maxVariablesPerQuery = 2;
+      // @ts-ignore: there are _way_ too many props to inject here :(
+      const editorRow = new QueryEditorRow({
+        data: dataWithoutWarnings,
+        query: {
+          refId: 'B',
+          expr: 'up{job="$job", instance="$instance"}',
+        },
+      });
+
+      expect(editorRow.renderWarnings()).toBe(null);
+    });
+  });
 });
@@ -37,6 +37,7 @@ import { t, Trans } from 'app/core/internationalization';
 import { getTimeSrv } from 'app/features/dashboard/services/TimeSrv';
 import { DashboardModel } from 'app/features/dashboard/state/DashboardModel';
 import { PanelModel } from 'app/features/dashboard/state/PanelModel';
+import { checkVariablesPerQueryLimit } from 'app/features/variables/utils';
 
 import { RowActionComponents } from './QueryActionComponent';
 import { QueryEditorRowHeader } from './QueryEditorRowHeader';
@@ -394,6 +395,18 @@ export class QueryEditorRow<TQuery extends DataQuery> extends PureComponent<Prop
       return acc.concat(warnings);
     }, []);
 
+    const variablesLimit = checkVariablesPerQueryLimit(query);
+    if (variablesLimit.exceedsLimit) {
+      allWarnings.push({
+        severity: 'warning',
+        text: t(
+          'query-operation.header.variables-limit-warning',
+          'The query references {{variables}} variables, more than the maximum of {{limit}}. Interpolating it can be slow.',
+          { variables: variablesLimit.count, limit: variablesLimit.limit }
+        ),
+      });
+    }
+
     const uniqueWarnings = uniqBy(allWarnings, 'text');
 
     const hasWarnings = uniqueWarnings.length > 0;
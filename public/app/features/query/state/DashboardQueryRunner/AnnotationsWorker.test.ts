@@ -1,5 +1,5 @@
-import { Subject, throwError } from 'rxjs';
-import { delay } from 'rxjs/operators';
+import { defer, of, Subject, throwError } from 'rxjs';
+import { delay, finalize } from 'rxjs/operators';
 
 import { AnnotationQuery } from '@grafana/data';
 import { DataSourceSrv, setDataSourceSrv, config } from '@grafana/runtime';
@@ -16,7 +16,7 @@ import {
 } from './DashboardQueryRunner';
 import { PublicAnnotationsDataSource } from './PublicAnnotationsDataSource';
 import { getDefaultOptions, LEGACY_DS_NAME, NEXT_GEN_DS_NAME, toAsyncOfResult } from './testHelpers';
-import { DashboardQueryRunnerOptions, DashboardQueryRunnerWorkerResult } from './types';
+import { AnnotationQueryRunner, DashboardQueryRunnerOptions, DashboardQueryRunnerWorkerResult } from './types';
 import { emptyResult } from './utils';
 
 function getTestContext(dataSourceSrvRejects = false) {
@@ -319,4 +319,50 @@ describe('AnnotationsWorker', () => {
       });
     });
   });
+
+  describe('when max concurrent annotation queries is configured', () => {
+    const originalMaxConcurrentAnnotationQueries = config.maxConcurrentAnnotationQueries;
+
+    afterEach(() => {
+      config.maxConcurrentAnnotationQueries = originalMaxConcurrentAnnotationQueries;
+    });
+
+    function getConcurrencyTestContext() {
+      const { options } = getTestContext();
+      let running = 0;
+      let maxRunning = 0;
+      const runner: AnnotationQueryRunner = {
+        canRun: () => true,
+        run: () =>
+          defer(() => {
+            running++;
+            maxRunning = Math.max(maxRunning, running);
+            return of([]).pipe(
+              delay(10),
+              finalize(() => running--)
+            );
+          }),
+      };
+
+      return { options, worker: new AnnotationsWorker([runner]), getMaxRunning: () => maxRunning };
+    }
+
+    it('then it should run all annotation queries at the same time when unlimited', async () => {
+      config.maxConcurrentAnnotationQueries = 0;
+      const { options, worker, getMaxRunning } = getConcurrencyTestContext();
+
+      await expect(worker.work(options)).toEmitValuesWith(() => {
+        expect(getMaxRunning()).toBe(2);
+      });
+    });
+
+    it('then it should not run more annotation queries than the limit at the same time', async () => {
+      config.maxConcurrentAnnotationQueries = 1;
+      const { options, worker, getMaxRunning } = getConcurrencyTestContext();
+
+      await expect(worker.work(options)).toEmitValuesWith(() => {
+        expect(getMaxRunning()).toBe(1);
+      });
+    });
+  });
 });
@@ -93,8 +93,11 @@ export class AnnotationsWorker implements DashboardQueryRunnerWorker {
       );
     });
 
+    const maxConcurrentQueries =
+      config.maxConcurrentAnnotationQueries > 0 ? config.maxConcurrentAnnotationQueries : Number.POSITIVE_INFINITY;
+
     return merge(observables).pipe(
-      mergeAll(),
+      mergeAll(maxConcurrentQueries),
       reduce((acc, value) => {
         // should we use scan or reduce here
         // reduce will only emit when all observables are completed
@@ -39,6 +39,7 @@ import { isSharedDashboardQuery, runSharedRequest } from '../../../plugins/datas
 import { PanelModel } from '../../dashboard/state';
 
 import { getDashboardQueryRunner } from './DashboardQueryRunner/DashboardQueryRunner';
+import { limitConcurrentTransformations } from './limitConcurrentTransformations';
 import { mergePanelAndDashData } from './mergePanelAndDashData';
 import { runRequest } from './runRequest';
 
@@ -221,7 +222,7 @@ export class PanelQueryRunner {
       interpolate: (v: string) => this.templateSrv.replace(v, data?.request?.scopedVars),
     };
 
-    return transformDataFrame(transformations, data.series, ctx).pipe(
+    return limitConcurrentTransformations(transformDataFrame(transformations, data.series, ctx)).pipe(
       map((series) => ({ ...data, series })),
       catchError((err) => {
         console.warn('Error running transformation:', err);
@@ -0,0 +1,49 @@
+import { Subject } from 'rxjs';
+
+import { config } from '@grafana/runtime';
+
+import { limitConcurrentTransformations } from './limitConcurrentTransformations';
+
+describe('limitConcurrentTransformations', () => {
+  const maxConcurrentTransformations = config.maxConcurrentTransformations;
+
+  afterEach(() => {
+    config.maxConcurrentTransformations = maxConcurrentTransformations;
+  });
+
+  it('runs at most the configured number of transformations at the same time', () => {
+    config.maxConcurrentTransformations = 2;
+    const sources = [new Subject<number>(), new Subject<number>(), new Subject<number>()];
+    const results: number[] = [];
+
+    sources.forEach((source) => {
+      limitConcurrentTransformations(source).subscribe((v) => results.push(v));
+    });
+
+    expect(sources.map((s) => s.observed)).toEqual([true, true, false]);
+
+    sources[0].next(1);
+    sources[0].complete();
+    expect(sources[2].observed).toBe(true);
+
+    sources[2].next(3);
+    expect(results).toEqual([1, 3]);
+
+    sources[1].complete();
+    sources[2].complete();
+  });
+
+  it('starts a queued transformation when a running one is unsubscribed', () => {
+    config.maxConcurrentTransformations = 1;
+    const first = new Subject<number>();
+    const second = new Subject<number>();
+
+    const subscription = limitConcurrentTransformations(first).subscribe();
+    limitConcurrentTransformations(second).subscribe();
+    expect(second.observed).toBe(false);
+
+    subscription.unsubscribe();
+    expect(second.observed).toBe(true);
+    second.complete();
+  });
+});
@@ -0,0 +1,71 @@
+import { Observable, Subscription } from 'rxjs';
+
+import { config } from '@grafana/runtime';
+
+export const DEFAULT_MAX_CONCURRENT_TRANSFORMATIONS = 4;
+
+let running = 0;
+const queue: Array<() => void> = [];
+
+function getMaxConcurrentTransformations(): number {
+  return config.maxConcurrentTransformations > 0
+    ? config.maxConcurrentTransformations
+    : DEFAULT_MAX_CONCURRENT_TRANSFORMATIONS;
+}
+
+function startQueued() {
+  while (running < getMaxConcurrentTransformations() && queue.length > 0) {
+    queue.shift()!();
+  }
+}
+
+/**
+ * Delays subscribing to a transformation until fewer than the configured maximum are running.
+ * A transformation is running until it completes, errors or is unsubscribed.
+ */
+export function limitConcurrentTransformations<T>(source: Observable<T>): Observable<T> {
+  return new Observable<T>((subscriber) => {
+    let subscription: Subscription | undefined;
+    let started = false;
+    let released = false;
+
+    const release = () => {
+      if (started && !released) {
+        released = true;
+        running--;
+        startQueued();
+      }
+    };
+
+    const start = () => {
+      started = true;
+      running++;
+      subscription = source.subscribe({
+        next: (value) => subscriber.next(value),
+        error: (err) => {
+          release();
+          subscriber.error(err);
+        },
+        complete: () => {
+          release();
+          subscriber.complete();
+        },
+      });
+    };
+
+    if (running < getMaxConcurrentTransformations()) {
+      start();
+    } else {
+      queue.push(start);
+    }
+
+    return () => {
+      const index = queue.indexOf(start);
+      if (index >= 0) {
+        queue.splice(index, 1);
+      }
+      subscription?.unsubscribe();
+      release();
+    };
+  });
+}
@@ -2,6 +2,7 @@ import { css, cx } from '@emotion/css';
 import React, { useEffect } from 'react';
 
 import { GrafanaTheme2 } from '@grafana/data';
+import { config } from '@grafana/runtime';
 import { useStyles2, FilterInput } from '@grafana/ui';
 import { contextSrv } from 'app/core/services/context_srv';
 import { FolderDTO, AccessControlAction } from 'app/types';
@@ -51,6 +52,7 @@ export const ManageDashboardsNew = React.memo(({ folder }: Props) => {
             spellCheck={false}
             placeholder={getSearchPlaceholder(state.includePanels)}
             escapeRegex={false}
+            maxLength={config.maxSearchQueryLength || undefined}
             className={styles.searchInput}
           />
         </div>
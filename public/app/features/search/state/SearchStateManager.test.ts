@@ -1,5 +1,5 @@
 import { DataFrameView } from '@grafana/data';
-import { locationService } from '@grafana/runtime';
+import { config, locationService } from '@grafana/runtime';
 
 import { DashboardQueryResult, getGrafanaSearcher } from '../service';
 import { SearchLayout } from '../types';
@@ -32,6 +32,32 @@ describe('SearchStateManager', () => {
     view: new DataFrameView<DashboardQueryResult>({ fields: [], length: 0 }),
   });
 
+  describe('with a maximum search query length', () => {
+    const originalMaxSearchQueryLength = config.maxSearchQueryLength;
+
+    beforeEach(() => {
+      config.maxSearchQueryLength = 5;
+    });
+
+    afterEach(() => {
+      config.maxSearchQueryLength = originalMaxSearchQueryLength;
+      locationService.partial({ query: null });
+    });
+
+    it('should cut typed queries to the limit', () => {
+      const stm = getSearchStateManager();
+      stm.onQueryChange('dashboard');
+      expect(stm.state.query).toBe('dashb');
+    });
+
+    it('should cut queries from the URL to the limit', () => {
+      const stm = getSearchStateManager();
+      locationService.partial({ query: 'dashboard' });
+      stm.initStateFromUrl();
+      expect(stm.state.query).toBe('dashb');
+    });
+  });
+
   it('Can get search state manager with initial state', async () => {
     const stm = getSearchStateManager();
     expect(stm.state.layout).toBe(SearchLayout.Folders);
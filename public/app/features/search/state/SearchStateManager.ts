@@ -1,7 +1,7 @@
 import { debounce } from 'lodash';
 import { FormEvent } from 'react';
 
-import { locationService } from '@grafana/runtime';
+import { config, locationService } from '@grafana/runtime';
 import { TermCount } from 'app/core/components/TagFilter/TagFilter';
 import { StateManagerBase } from 'app/core/services/StateManagerBase';
 import store from 'app/core/store';
@@ -43,6 +43,14 @@ const getLocalStorageLayout = () => {
     return SearchLayout.Folders;
   }
 };
+/**
+ * Cuts the query to the configured maximum search query length, long queries can time out.
+ */
+export const limitSearchQuery = (query: string) => {
+  const maxLength = config.maxSearchQueryLength;
+  return maxLength > 0 ? query.slice(0, maxLength) : query;
+};
+
 export class SearchStateManager extends StateManagerBase<SearchState> {
   updateLocation = debounce((query) => locationService.partial(query, true), 300);
   doSearchWithDebounce = debounce(() => this.doSearch(), 300);
@@ -62,6 +70,10 @@ export class SearchStateManager extends StateManagerBase<SearchState> {
     const prevSort = localStorage.getItem(SEARCH_SELECTED_SORT) ?? undefined;
     const sort = layout === SearchLayout.List ? stateFromUrl.sort || prevSort : null;
 
+    if (stateFromUrl.query) {
+      stateFromUrl.query = limitSearchQuery(stateFromUrl.query);
+    }
+
     stateManager.setState({
       ...initialState,
       ...stateFromUrl,
@@ -123,7 +135,7 @@ export class SearchStateManager extends StateManagerBase<SearchState> {
   };
 
   onQueryChange = (query: string) => {
-    this.setStateAndDoSearch({ query });
+    this.setStateAndDoSearch({ query: limitSearchQuery(query) });
   };
 
   onRemoveTag = (tagToRemove: string) => {
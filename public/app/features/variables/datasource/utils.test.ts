@@ -0,0 +1,52 @@
+import { config } from '@grafana/runtime';
+
+import { constantBuilder, datasourceBuilder } from '../shared/testing/builders';
+
+import { setDefaultDataSourceFilter } from './utils';
+
+describe('setDefaultDataSourceFilter', () => {
+  const originalDefaultDatasourceVariableFilter = config.defaultDatasourceVariableFilter;
+
+  afterEach(() => {
+    config.defaultDatasourceVariableFilter = originalDefaultDatasourceVariableFilter;
+  });
+
+  it('should not change the variable without a configured filter', () => {
+    config.defaultDatasourceVariableFilter = '';
+    const variable = datasourceBuilder().withId('ds').withName('ds').build();
+
+    setDefaultDataSourceFilter(variable);
+
+    expect(variable.query).toBe('');
+    expect(variable.regex).toBe('');
+  });
+
+  it('should set the configured data source type', () => {
+    config.defaultDatasourceVariableFilter = 'prometheus';
+    const variable = datasourceBuilder().withId('ds').withName('ds').build();
+
+    setDefaultDataSourceFilter(variable);
+
+    expect(variable.query).toBe('prometheus');
+    expect(variable.regex).toBe('');
+  });
+
+  it('should set the configured data source type and instance name filter', () => {
+    config.defaultDatasourceVariableFilter = 'prometheus:/^prod:(.*)/';
+    const variable = datasourceBuilder().withId('ds').withName('ds').build();
+
+    setDefaultDataSourceFilter(variable);
+
+    expect(variable.query).toBe('prometheus');
+    expect(variable.regex).toBe('/^prod:(.*)/');
+  });
+
+  it('should ignore other variable types', () => {
+    config.defaultDatasourceVariableFilter = 'prometheus';
+    const variable = constantBuilder().withId('constant').withName('constant').withQuery('value').build();
+
+    setDefaultDataSourceFilter(variable);
+
+    expect(variable.query).toBe('value');
+  });
+});
@@ -0,0 +1,16 @@
+import { TypedVariableModel } from '@grafana/data';
+import { config } from '@grafana/runtime';
+
+/**
+ * Pre-fills the type and instance name filter of a new data source variable from the
+ * configured default filter, formatted as the data source type optionally followed by `:<regex>`.
+ */
+export function setDefaultDataSourceFilter(model: TypedVariableModel) {
+  if (model.type !== 'datasource' || !config.defaultDatasourceVariableFilter) {
+    return;
+  }
+
+  const [query, ...regex] = config.defaultDatasourceVariableFilter.split(':');
+  model.query = query;
+  model.regex = regex.join(':');
+}
@@ -1,7 +1,11 @@
+import { config } from '@grafana/runtime';
+
 import { initialState } from '../../dashboard/state/reducers';
 import { variableAdapters } from '../adapters';
 import { createConstantVariableAdapter } from '../constant/adapter';
 import { initialConstantVariableModelState } from '../constant/reducer';
+import { createCustomVariableAdapter } from '../custom/adapter';
+import { initialCustomVariableModelState } from '../custom/reducer';
 import * as inspectUtils from '../inspect/utils';
 import { constantBuilder, customBuilder } from '../shared/testing/builders';
 import { initialKeyedVariablesState, toKeyedAction } from '../state/keyedVariablesReducer';
@@ -66,3 +70,93 @@ describe('initListMode', () => {
     expect(mockDispatch.mock.calls[0][0]).toMatchObject(keyedAction);
   });
 });
+
+describe('createNewVariable with a default all value', () => {
+  variableAdapters.setInit(() => [createConstantVariableAdapter(), createCustomVariableAdapter()]);
+  const originalDefaultAllValue = config.defaultAllValue;
+
+  beforeEach(() => {
+    jest.spyOn(selectors, 'getVariablesByKey').mockReturnValue([]);
+    jest.spyOn(selectors, 'getNewVariableIndex').mockReturnValue(0);
+  });
+
+  afterEach(() => {
+    config.defaultAllValue = originalDefaultAllValue;
+  });
+
+  it('should keep the wildcard all value when not configured', () => {
+    config.defaultAllValue = '';
+    const mockGetState = jest.fn().mockReturnValue({ templating: initialKeyedVariablesState });
+    const mockDispatch = jest.fn();
+
+    createNewVariable(null, 'custom')(mockDispatch, mockGetState, undefined);
+
+    expect(mockDispatch.mock.calls[0][0].payload.action.payload.data.model.allValue).toBe(
+      initialCustomVariableModelState.allValue
+    );
+  });
+
+  it('should use the configured all value for new variables', () => {
+    config.defaultAllValue = '.*';
+    const mockGetState = jest.fn().mockReturnValue({ templating: initialKeyedVariablesState });
+    const mockDispatch = jest.fn();
+
+    createNewVariable(null, 'custom')(mockDispatch, mockGetState, undefined);
+
+    expect(mockDispatch.mock.calls[0][0].payload.action.payload.data.model.allValue).toBe('.*');
+  });
+
+  it('should not add an all value to variables without one', () => {
+    config.defaultAllValue = '.*';
+    const mockGetState = jest.fn().mockReturnValue({ templating: initialKeyedVariablesState });
+    const mockDispatch = jest.fn();
+
+    createNewVariable(null, 'constant')(mockDispatch, mockGetState, undefined);
+
+    expect(mockDispatch.mock.calls[0][0].payload.action.payload.data.model).not.toHaveProperty('allValue');
+  });
+});
+
+describe('createNewVariable with default multi-select', () => {
+  variableAdapters.setInit(() => [createConstantVariableAdapter(), createCustomVariableAdapter()]);
+  const originalDefaultVariableMultiSelect = config.defaultVariableMultiSelect;
+
+  beforeEach(() => {
+    jest.spyOn(selectors, 'getVariablesByKey').mockReturnValue([]);
+    jest.spyOn(selectors, 'getNewVariableIndex').mockReturnValue(0);
+  });
+
+  afterEach(() => {
+    config.defaultVariableMultiSelect = originalDefaultVariableMultiSelect;
+  });
+
+  it('should create single-select variables when not configured', () => {
+    config.defaultVariableMultiSelect = false;
+    const mockGetState = jest.fn().mockReturnValue({ templating: initialKeyedVariablesState });
+    const mockDispatch = jest.fn();
+
+    createNewVariable(null, 'custom')(mockDispatch, mockGetState, undefined);
+
+    expect(mockDispatch.mock.calls[0][0].payload.action.payload.data.model.multi).toBe(false);
+  });
+
+  it('should create multi-select variables when configured', () => {
+    config.defaultVariableMultiSelect = true;
+    const mockGetState = jest.fn().mockReturnValue({ templating: initialKeyedVariablesState });
+    const mockDispatch = jest.fn();
+
+    createNewVariable(null, 'custom')(mockDispatch, mockGetState, undefined);
+
+    expect(mockDispatch.mock.calls[0][0].payload.action.payload.data.model.multi).toBe(true);
+  });
+
+  it('should not add multi-select to variables without it', () => {
+    config.defaultVariableMultiSelect = true;
+    const mockGetState = jest.fn().mockReturnValue({ templating: initialKeyedVariablesState });
+    const mockDispatch = jest.fn();
+
+    createNewVariable(null, 'constant')(mockDispatch, mockGetState, undefined);
+
+    expect(mockDispatch.mock.calls[0][0].payload.action.payload.data.model).not.toHaveProperty('multi');
+  });
+});
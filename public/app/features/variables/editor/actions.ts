@@ -1,17 +1,18 @@
 import { cloneDeep } from 'lodash';
 
 import { TypedVariableModel, VariableType } from '@grafana/data';
-import { locationService } from '@grafana/runtime';
+import { config, locationService } from '@grafana/runtime';
 
 import { ThunkResult } from '../../../types';
 import { variableAdapters } from '../adapters';
+import { setDefaultDataSourceFilter } from '../datasource/utils';
 import { initInspect } from '../inspect/reducer';
 import { createUsagesNetwork, transformUsagesToNetwork } from '../inspect/utils';
 import { toKeyedAction } from '../state/keyedVariablesReducer';
 import { getEditorVariables, getNewVariableIndex, getVariable, getVariablesByKey } from '../state/selectors';
 import { addVariable, removeVariable } from '../state/sharedReducer';
 import { AddVariable, KeyedVariableIdentifier, VariableIdentifier } from '../state/types';
-import { toKeyedVariableIdentifier, toStateKey, toVariablePayload } from '../utils';
+import { setDefaultMultiSelect, toKeyedVariableIdentifier, toStateKey, toVariablePayload } from '../utils';
 
 import {
   changeVariableNameFailed,
@@ -98,6 +99,11 @@ export const createNewVariable =
     model.id = id;
     model.name = id;
     model.rootStateKey = rootStateKey;
+    if (config.defaultAllValue && 'allValue' in model) {
+      model.allValue = config.defaultAllValue;
+    }
+    setDefaultDataSourceFilter(model);
+    setDefaultMultiSelect(model);
     dispatch(
       toKeyedAction(rootStateKey, addVariable(toVariablePayload<AddVariable>(identifier, { global, model, index })))
     );
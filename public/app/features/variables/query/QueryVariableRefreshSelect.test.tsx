@@ -0,0 +1,38 @@
+import { render, screen } from '@testing-library/react';
+import React from 'react';
+
+import { VariableRefresh } from '@grafana/data';
+import { config } from '@grafana/runtime';
+
+import { QueryVariableRefreshSelect } from './QueryVariableRefreshSelect';
+
+describe('QueryVariableRefreshSelect', () => {
+  const originalMinVariableRefreshIntervalSeconds = config.minVariableRefreshIntervalSeconds;
+
+  afterEach(() => {
+    config.minVariableRefreshIntervalSeconds = originalMinVariableRefreshIntervalSeconds;
+  });
+
+  it('should not mention a minimum interval by default', () => {
+    config.minVariableRefreshIntervalSeconds = 0;
+    render(<QueryVariableRefreshSelect onChange={jest.fn()} refresh={VariableRefresh.onTimeRangeChanged} />);
+
+    expect(screen.getByText('When to update the values of this variable')).toBeInTheDocument();
+  });
+
+  it('should mention the minimum interval when refreshing on time range change', () => {
+    config.minVariableRefreshIntervalSeconds = 30;
+    render(<QueryVariableRefreshSelect onChange={jest.fn()} refresh={VariableRefresh.onTimeRangeChanged} />);
+
+    expect(
+      screen.getByText('When to update the values of this variable, at most once every 30 seconds')
+    ).toBeInTheDocument();
+  });
+
+  it('should not mention the minimum interval when refreshing on dashboard load', () => {
+    config.minVariableRefreshIntervalSeconds = 30;
+    render(<QueryVariableRefreshSelect onChange={jest.fn()} refresh={VariableRefresh.onDashboardLoad} />);
+
+    expect(screen.getByText('When to update the values of this variable')).toBeInTheDocument();
+  });
+});
@@ -1,6 +1,7 @@
 import React, { PropsWithChildren, useMemo, useState } from 'react';
 
 import { VariableRefresh } from '@grafana/data';
+import { config } from '@grafana/runtime';
 import { Field, RadioButtonGroup, useTheme2 } from '@grafana/ui';
 import { useMediaQueryChange } from 'app/core/hooks/useMediaQueryChange';
 
@@ -30,8 +31,13 @@ export function QueryVariableRefreshSelect({ onChange, refresh }: PropsWithChild
     [refresh]
   );
 
+  let description = 'When to update the values of this variable';
+  if (value === VariableRefresh.onTimeRangeChanged && config.minVariableRefreshIntervalSeconds > 0) {
+    description += `, at most once every ${config.minVariableRefreshIntervalSeconds} seconds`;
+  }
+
   return (
-    <Field label="Refresh" description="When to update the values of this variable">
+    <Field label="Refresh" description={description}>
       <RadioButtonGroup
         options={REFRESH_OPTIONS}
         onChange={onChange}
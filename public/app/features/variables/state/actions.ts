@@ -772,7 +772,8 @@ export const onTimeRangeUpdated =
   (
     key: string,
     timeRange: TimeRange,
-    dependencies: OnTimeRangeUpdatedDependencies = { templateSrv: getTemplateSrv(), events: appEvents }
+    dependencies: OnTimeRangeUpdatedDependencies = { templateSrv: getTemplateSrv(), events: appEvents },
+    refreshVariables = true
   ): ThunkResult<Promise<void>> =>
   async (dispatch, getState) => {
     dependencies.templateSrv.updateTimeRange(timeRange);
@@ -780,7 +781,10 @@ export const onTimeRangeUpdated =
     // approach # 2, get variables that need refresh but use the dependency graph to only update the ones that are affected
     // TODO: remove the VariableWithOptions type once the feature flag is on GA
     let variablesThatNeedRefresh: VariableWithOptions[] | TypedVariableModel[] = [];
-    if (config.featureToggles.refactorVariablesTimeRange) {
+    if (!refreshVariables) {
+      // auto-refresh ticks within minVariableRefreshIntervalSeconds only refresh the panels
+      variablesThatNeedRefresh = [];
+    } else if (config.featureToggles.refactorVariablesTimeRange) {
       variablesThatNeedRefresh = getVariablesThatNeedRefreshNew(key, getState());
     } else {
       variablesThatNeedRefresh = getVariablesThatNeedRefreshOld(key, getState());
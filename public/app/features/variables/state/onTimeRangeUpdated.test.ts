@@ -17,7 +17,7 @@ import { createIntervalVariableAdapter } from '../interval/adapter';
 import { createIntervalOptions } from '../interval/reducer';
 import { createQueryVariableAdapter } from '../query/adapter';
 import { constantBuilder, intervalBuilder, queryBuilder, datasourceBuilder } from '../shared/testing/builders';
-import { VariableRefresh } from '../types';
+import { VariableRefresh, VariablesTimeRangeProcessDone } from '../types';
 import { toKeyedVariableIdentifier, toVariablePayload } from '../utils';
 
 import { onTimeRangeUpdated, OnTimeRangeUpdatedDependencies, setOptionAsCurrent } from './actions';
@@ -564,6 +564,26 @@ describe('when onTimeRangeUpdated is dispatched', () => {
       spyTimeRangeUpdated.mockRestore();
     });
   });
+
+  describe('When onTimeRangeUpdated is dispatched without refreshing variables', () => {
+    it('then no variables are refreshed', async () => {
+      const { key, preloadedState, range, dependencies } = getTestContextVariables(getDashboardModel(), {});
+      const spyTimeRangeUpdated = jest.spyOn(actions, 'timeRangeUpdated');
+      const publishSpy = jest.spyOn(dependencies.events, 'publish');
+
+      await reduxTester<RootReducerType>({ preloadedState })
+        .givenRootReducer(getRootReducer())
+        .whenActionIsDispatched(toKeyedAction(key, variablesInitTransaction({ uid: key })))
+        .whenAsyncActionIsDispatched(onTimeRangeUpdated(key, range, dependencies, false), true);
+
+      expect(spyTimeRangeUpdated).not.toHaveBeenCalled();
+      expect(dependencies.templateSrv.updateTimeRange).toHaveBeenCalledWith(range);
+      expect(publishSpy).toHaveBeenCalledWith(new VariablesTimeRangeProcessDone({ variableIds: [] }));
+
+      spyTimeRangeUpdated.mockRestore();
+      publishSpy.mockRestore();
+    });
+  });
 });
 
 function getDashboardModel(): DashboardModel {
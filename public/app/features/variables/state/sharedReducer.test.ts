@@ -1,12 +1,14 @@
 import { cloneDeep } from 'lodash';
 
 import { BaseVariableModel, LoadingState, VariableType } from '@grafana/data';
+import { config } from '@grafana/runtime';
 
 import { reducerTester } from '../../../../test/core/redux/reducerTester';
 import { variableAdapters } from '../adapters';
 import { createConstantVariableAdapter } from '../constant/adapter';
 import { initialConstantVariableModelState } from '../constant/reducer';
 import { ALL_VARIABLE_TEXT, ALL_VARIABLE_VALUE } from '../constants';
+import { createDataSourceVariableAdapter } from '../datasource/adapter';
 import { changeVariableNameSucceeded } from '../editor/reducer';
 import { createQueryVariableAdapter } from '../query/adapter';
 import { initialQueryVariableModelState } from '../query/reducer';
@@ -31,7 +33,11 @@ import {
 } from './sharedReducer';
 import { initialVariablesState, KeyedVariableIdentifier, VariablesState } from './types';
 
-variableAdapters.setInit(() => [createQueryVariableAdapter(), createConstantVariableAdapter()]);
+variableAdapters.setInit(() => [
+  createQueryVariableAdapter(),
+  createConstantVariableAdapter(),
+  createDataSourceVariableAdapter(),
+]);
 
 describe('sharedReducer', () => {
   describe('when addVariable is dispatched', () => {
@@ -523,5 +529,33 @@ describe('sharedReducer', () => {
           },
         });
     });
+
+    it('then the default data source filter should be set when changing to a data source variable', () => {
+      const originalDefaultDatasourceVariableFilter = config.defaultDatasourceVariableFilter;
+      config.defaultDatasourceVariableFilter = 'prometheus:/^prod/';
+      const queryAdapter = createQueryVariableAdapter();
+      const { initialState: queryAdapterState } = getVariableTestContext(queryAdapter);
+      const identifier: KeyedVariableIdentifier = { id: '0', type: 'query', rootStateKey: 'key' };
+      const payload = toVariablePayload(identifier, { newType: 'datasource' as VariableType });
+
+      const state = sharedReducer(cloneDeep(queryAdapterState), changeVariableType(payload));
+      config.defaultDatasourceVariableFilter = originalDefaultDatasourceVariableFilter;
+
+      expect(state['0']).toMatchObject({ type: 'datasource', query: 'prometheus', regex: '/^prod/' });
+    });
+
+    it('then multi-select should be turned on when configured as the default', () => {
+      const originalDefaultVariableMultiSelect = config.defaultVariableMultiSelect;
+      config.defaultVariableMultiSelect = true;
+      const queryAdapter = createQueryVariableAdapter();
+      const { initialState: queryAdapterState } = getVariableTestContext(queryAdapter);
+      const identifier: KeyedVariableIdentifier = { id: '0', type: 'query', rootStateKey: 'key' };
+      const payload = toVariablePayload(identifier, { newType: 'datasource' as VariableType });
+
+      const state = sharedReducer(cloneDeep(queryAdapterState), changeVariableType(payload));
+      config.defaultVariableMultiSelect = originalDefaultVariableMultiSelect;
+
+      expect(state['0']).toMatchObject({ type: 'datasource', multi: true });
+    });
   });
 });
@@ -4,9 +4,10 @@ import { cloneDeep, defaults as lodashDefaults } from 'lodash';
 import { LoadingState, VariableType, TypedVariableModel, VariableOption } from '@grafana/data';
 
 import { variableAdapters } from '../adapters';
+import { setDefaultDataSourceFilter } from '../datasource/utils';
 import { changeVariableNameSucceeded } from '../editor/reducer';
 import { hasOptions } from '../guard';
-import { ensureStringValues } from '../utils';
+import { ensureStringValues, setDefaultMultiSelect } from '../utils';
 
 import { getInstanceState, getNextVariableIndex } from './selectors';
 import { AddVariable, initialVariablesState, VariablePayload, VariablesState } from './types';
@@ -135,6 +136,8 @@ const sharedReducerSlice = createSlice({
         index,
         description,
       };
+      setDefaultDataSourceFilter(state[id]);
+      setDefaultMultiSelect(state[id]);
     },
     setCurrentVariableValue: (
       state: VariablesState,
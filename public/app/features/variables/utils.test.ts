@@ -1,11 +1,14 @@
 import { UrlQueryMap } from '@grafana/data';
+import { config } from '@grafana/runtime';
 
 import { VariableRefresh } from './types';
 import {
+  checkVariablesPerQueryLimit,
   containsVariable,
   ensureStringValues,
   findTemplateVarChanges,
   getCurrentText,
+  getReferencedVariableNames,
   getVariableRefresh,
   isAllVariable,
 } from './utils';
@@ -202,3 +205,46 @@ describe('containsVariable', () => {
     expect(containsVariable(value, 'var')).toEqual(expected);
   });
 });
+
+describe('getReferencedVariableNames', () => {
+  it('returns the distinct variables referenced with every syntax', () => {
+    const query = {
+      refId: 'A',
+      expr: 'up{job="$job", instance=~"${instance:regex}"} + [[job]]',
+      legend: '${host.name}',
+    };
+
+    expect(getReferencedVariableNames(query)).toEqual(['job', 'instance', 'host']);
+  });
+
+  it('does not return built-in variables', () => {
+    expect(getReferencedVariableNames('rate(up[$__rate_interval]) / $__interval_ms * $scale')).toEqual(['scale']);
+  });
+});
+
+describe('checkVariablesPerQueryLimit', () => {
+  const originalMaxVariablesPerQuery = config.maxVariablesPerQuery;
+  const query = { refId: 'A', expr: 'up{job="$job", instance="$instance", env="$env"}' };
+
+  afterEach(() => {
+    config.maxVariablesPerQuery = originalMaxVariablesPerQuery;
+  });
+
+  it('does not exceed the limit when there is none', () => {
+    config.maxVariablesPerQuery = 0;
+
+    expect(checkVariablesPerQueryLimit(query).exceedsLimit).toBe(false);
+  });
+
+  it('does not exceed the limit with as many variables as the limit', () => {
+    config.maxVariablesPerQuery = 3;
+
+    expect(checkVariablesPerQueryLimit(query)).toEqual({ limit: 3, count: 3, exceedsLimit: false });
+  });
+
+  it('exceeds the limit with more variables than the limit', () => {
+    config.maxVariablesPerQuery = 2;
+
+    expect(checkVariablesPerQueryLimit(query)).toEqual({ limit: 2, count: 3, exceedsLimit: true });
+  });
+});
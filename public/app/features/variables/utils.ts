@@ -9,8 +9,9 @@ import {
   VariableRefresh,
   VariableWithOptions,
   QueryVariableModel,
+  TypedVariableModel,
 } from '@grafana/data';
-import { getTemplateSrv } from '@grafana/runtime';
+import { config, getTemplateSrv } from '@grafana/runtime';
 import { safeStringifyValue } from 'app/core/utils/explore';
 
 import { getState } from '../../store/store';
@@ -54,6 +55,36 @@ export function containsVariable(...args: any[]) {
   return !!isMatchingVariable;
 }
 
+/**
+ * Returns the distinct names of the variables referenced in a value such as a query,
+ * built-in variables like $__interval aren't included.
+ */
+export function getReferencedVariableNames(value: unknown): string[] {
+  const variableString = typeof value === 'string' ? value : safeStringifyValue(value);
+  const names = new Set<string>();
+
+  for (const match of variableString.matchAll(variableRegex)) {
+    const name = match[1] || match[2] || match[4];
+    if (name && !name.startsWith('__')) {
+      names.add(name);
+    }
+  }
+
+  return [...names];
+}
+
+export function checkVariablesPerQueryLimit(query: unknown) {
+  const limit = config.maxVariablesPerQuery;
+
+  // 0 means there is no configured limit
+  if (limit <= 0) {
+    return { limit, count: 0, exceedsLimit: false };
+  }
+
+  const count = getReferencedVariableNames(query).length;
+  return { limit, count, exceedsLimit: count > limit };
+}
+
 export const isAllVariable = (variable: any): boolean => {
   if (!variable) {
     return false;
@@ -292,3 +323,12 @@ export function toVariablePayload<T extends any = undefined>(
 ): VariablePayload<T> {
   return { type: obj.type, id: obj.id, data: data as T };
 }
+
+/**
+ * Turns on multi-select for new variables that support it when it's configured as the default.
+ */
+export function setDefaultMultiSelect(model: TypedVariableModel) {
+  if (config.defaultVariableMultiSelect && 'multi' in model) {
+    model.multi = true;
+  }
+}
@@ -0,0 +1,36 @@
+import { LogsSortOrder, PanelModel } from '@grafana/data';
+import { config } from '@grafana/runtime';
+
+import { plugin } from './module';
+import { Options } from './types';
+
+const changePanelType = (panel: Partial<PanelModel<Options>> & { isNew?: boolean }) =>
+  plugin.onPanelTypeChanged!(panel as PanelModel<Options>, 'timeseries', {}, { defaults: {}, overrides: [] });
+
+describe('logs panel change handler', () => {
+  const originalDefaultLogsSortOrder = config.defaultLogsSortOrder;
+
+  afterEach(() => {
+    config.defaultLogsSortOrder = originalDefaultLogsSortOrder;
+  });
+
+  it('should keep the built-in sort order when none is configured', () => {
+    config.defaultLogsSortOrder = '';
+    expect(changePanelType({ isNew: true, options: {} as Options })).toEqual({});
+  });
+
+  it('should use the configured sort order for new panels', () => {
+    config.defaultLogsSortOrder = 'asc';
+    expect(changePanelType({ isNew: true, options: {} as Options })).toEqual({ sortOrder: LogsSortOrder.Ascending });
+  });
+
+  it('should not change the sort order of existing panels', () => {
+    config.defaultLogsSortOrder = 'asc';
+    expect(changePanelType({ options: {} as Options })).toEqual({});
+  });
+
+  it('should keep the sort order already chosen for the panel', () => {
+    config.defaultLogsSortOrder = 'asc';
+    expect(changePanelType({ isNew: true, options: { sortOrder: LogsSortOrder.Descending } as Options })).toEqual({});
+  });
+});
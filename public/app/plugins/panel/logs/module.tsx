@@ -1,4 +1,5 @@
-import { PanelPlugin, LogsSortOrder, LogsDedupStrategy, LogsDedupDescription } from '@grafana/data';
+import { PanelPlugin, LogsSortOrder, LogsDedupStrategy, LogsDedupDescription, PanelModel } from '@grafana/data';
+import { config } from '@grafana/runtime';
 
 import { LogsPanel } from './LogsPanel';
 import { LogsPanelSuggestionsSupplier } from './suggestions';
@@ -82,4 +83,13 @@ export const plugin = new PanelPlugin<Options>(LogsPanel)
         defaultValue: LogsSortOrder.Descending,
       });
   })
+  // The configured sort order only applies to new panels, existing panels keep the built-in default
+  .setPanelChangeHandler((panel: PanelModel<Options> & { isNew?: boolean }) => {
+    if (!panel.isNew || !config.defaultLogsSortOrder || panel.options?.sortOrder) {
+      return {};
+    }
+    return {
+      sortOrder: config.defaultLogsSortOrder === 'asc' ? LogsSortOrder.Ascending : LogsSortOrder.Descending,
+    };
+  })
   .setSuggestionsSupplier(new LogsPanelSuggestionsSupplier());
@@ -0,0 +1,33 @@
+import { PanelOptionsEditorBuilder, ReducerID, standardEditorsRegistry } from '@grafana/data';
+import { config } from '@grafana/runtime';
+
+import { addStandardDataReduceOptions } from './common';
+import { Options } from './panelcfg.gen';
+
+standardEditorsRegistry.setInit(() => [{ id: 'stats-picker', name: 'Stats picker', editor: () => null }]);
+
+function getDefaultCalcs() {
+  const builder = new PanelOptionsEditorBuilder<Options>();
+  addStandardDataReduceOptions(builder);
+  return builder.getItems().find((item) => item.path === 'reduceOptions.calcs')?.defaultValue;
+}
+
+describe('addStandardDataReduceOptions', () => {
+  const originalDefaultReduceCalc = config.defaultReduceCalc;
+
+  afterEach(() => {
+    config.defaultReduceCalc = originalDefaultReduceCalc;
+  });
+
+  it('should default to the last non-null value', () => {
+    config.defaultReduceCalc = '';
+
+    expect(getDefaultCalcs()).toEqual([ReducerID.lastNotNull]);
+  });
+
+  it('should default to the configured calculation', () => {
+    config.defaultReduceCalc = ReducerID.mean;
+
+    expect(getDefaultCalcs()).toEqual([ReducerID.mean]);
+  });
+});
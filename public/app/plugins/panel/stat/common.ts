@@ -8,6 +8,7 @@ import {
   ReducerID,
   standardEditorsRegistry,
 } from '@grafana/data';
+import { config } from '@grafana/runtime';
 import { SingleStatBaseOptions, VizOrientation } from '@grafana/schema';
 
 export function addStandardDataReduceOptions<T extends SingleStatBaseOptions>(
@@ -52,7 +53,7 @@ export function addStandardDataReduceOptions<T extends SingleStatBaseOptions>(
     category: valueOptionsCategory,
     editor: standardEditorsRegistry.get('stats-picker').editor,
     // TODO: Get ReducerID from generated schema one day?
-    defaultValue: [ReducerID.lastNotNull],
+    defaultValue: [config.defaultReduceCalc || ReducerID.lastNotNull],
     // Hides it when all values mode is on
     showIf: (currentConfig) => currentConfig.reduceOptions.values === false,
   });
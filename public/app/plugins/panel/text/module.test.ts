@@ -0,0 +1,36 @@
+import { PanelModel } from '@grafana/data';
+import { config } from '@grafana/runtime';
+
+import { plugin } from './module';
+import { Options } from './panelcfg.gen';
+
+const changePanelType = (panel: Partial<PanelModel<Options>> & { isNew?: boolean }) =>
+  plugin.onPanelTypeChanged!(panel as PanelModel<Options>, 'timeseries', {}, { defaults: {}, overrides: [] });
+
+describe('text panel change handler', () => {
+  const originalDefaultTextPanelContent = config.defaultTextPanelContent;
+
+  afterEach(() => {
+    config.defaultTextPanelContent = originalDefaultTextPanelContent;
+  });
+
+  it('should keep the built-in content when none is configured', () => {
+    config.defaultTextPanelContent = '';
+    expect(changePanelType({ isNew: true, options: {} as Options })).toEqual({});
+  });
+
+  it('should use the configured content for new panels', () => {
+    config.defaultTextPanelContent = '# Team notes';
+    expect(changePanelType({ isNew: true, options: {} as Options })).toEqual({ content: '# Team notes' });
+  });
+
+  it('should not change the content of existing panels', () => {
+    config.defaultTextPanelContent = '# Team notes';
+    expect(changePanelType({ options: {} as Options })).toEqual({});
+  });
+
+  it('should keep content already written for the panel', () => {
+    config.defaultTextPanelContent = '# Team notes';
+    expect(changePanelType({ isNew: true, options: { content: 'Hello' } as Options })).toEqual({});
+  });
+});
@@ -1,4 +1,5 @@
-import { PanelPlugin } from '@grafana/data';
+import { PanelModel, PanelPlugin } from '@grafana/data';
+import { config } from '@grafana/runtime';
 
 import { TextPanel } from './TextPanel';
 import { TextPanelEditor } from './TextPanelEditor';
@@ -52,4 +53,11 @@ export const plugin = new PanelPlugin<Options>(TextPanel)
         defaultValue: defaultOptions.content,
       });
   })
+  // The configured content only applies to new panels, existing panels keep the built-in default
+  .setPanelChangeHandler((panel: PanelModel<Options> & { isNew?: boolean }) => {
+    if (!panel.isNew || !config.defaultTextPanelContent || panel.options?.content) {
+      return {};
+    }
+    return { content: config.defaultTextPanelContent };
+  })
   .setMigrationHandler(textPanelMigrationHandler);
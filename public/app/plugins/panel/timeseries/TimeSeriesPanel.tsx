@@ -31,8 +31,15 @@ export const TimeSeriesPanel = ({
   replaceVariables,
   id,
 }: TimeSeriesPanelProps) => {
-  const { sync, canAddAnnotations, onThresholdsChange, canEditThresholds, showThresholds, dataLinkPostProcessor } =
-    usePanelContext();
+  const {
+    sync,
+    canAddAnnotations,
+    annotateOnSelect,
+    onThresholdsChange,
+    canEditThresholds,
+    showThresholds,
+    dataLinkPostProcessor,
+  } = usePanelContext();
 
   const frames = useMemo(() => prepareGraphableFields(data.series, config.theme2, timeRange), [data.series, timeRange]);
   const timezones = useMemo(() => getTimezones(options.timezone, timeZone), [options.timezone, timeZone]);
@@ -106,7 +113,12 @@ export const TimeSeriesPanel = ({
             )}
             {/* Enables annotations creation*/}
             {enableAnnotationCreation ? (
-              <AnnotationEditorPlugin data={alignedDataFrame} timeZone={timeZone} config={config}>
+              <AnnotationEditorPlugin
+                data={alignedDataFrame}
+                timeZone={timeZone}
+                config={config}
+                annotateOnSelect={annotateOnSelect}
+              >
                 {({ startAnnotating }) => {
                   return (
                     <ContextMenuPlugin
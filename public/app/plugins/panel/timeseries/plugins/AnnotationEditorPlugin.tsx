@@ -16,13 +16,21 @@ interface AnnotationEditorPluginProps {
   data: DataFrame;
   timeZone: TimeZone;
   config: UPlotConfigBuilder;
+  // annotate any selected range, not only when Ctrl/Cmd is held
+  annotateOnSelect?: boolean;
   children?: (props: { startAnnotating: StartAnnotatingFn }) => React.ReactNode;
 }
 
 /**
  * @alpha
  */
-export const AnnotationEditorPlugin = ({ data, timeZone, config, children }: AnnotationEditorPluginProps) => {
+export const AnnotationEditorPlugin = ({
+  data,
+  timeZone,
+  config,
+  annotateOnSelect,
+  children,
+}: AnnotationEditorPluginProps) => {
   const plotInstance = useRef<uPlot>();
   const [bbox, setBbox] = useState<DOMRect>();
   const [isAddingAnnotation, setIsAddingAnnotation] = useState(false);
@@ -89,7 +97,7 @@ export const AnnotationEditorPlugin = ({ data, timeZone, config, children }: Ann
     config.setCursor({
       bind: {
         mousedown: (u, targ, handler) => (e) => {
-          annotating = e.button === 0 && (e.metaKey || e.ctrlKey);
+          annotating = e.button === 0 && (annotateOnSelect || e.metaKey || e.ctrlKey);
           handler(e);
           return null;
         },
@@ -97,15 +105,20 @@ export const AnnotationEditorPlugin = ({ data, timeZone, config, children }: Ann
           // uPlot will not fire setSelect hooks for 0-width && 0-height selections
           // so we force it to fire on single-point clicks by mutating left & height
           if (annotating && u.select.width === 0) {
-            u.select.left = u.cursor.left!;
-            u.select.height = u.bbox.height / window.devicePixelRatio;
+            if (e.metaKey || e.ctrlKey) {
+              u.select.left = u.cursor.left!;
+              u.select.height = u.bbox.height / window.devicePixelRatio;
+            } else {
+              // with annotateOnSelect only a selected range is annotated, not a plain click
+              annotating = false;
+            }
           }
           handler(e);
           return null;
         },
       },
     });
-  }, [config, setBbox, isMounted]);
+  }, [config, setBbox, isMounted, annotateOnSelect]);
 
   const startAnnotating = useCallback<StartAnnotatingFn>(
     ({ coords }) => {
@@ -1,10 +1,11 @@
-import React, { useCallback, useEffect, useLayoutEffect, useRef } from 'react';
+import React, { useCallback, useEffect, useLayoutEffect, useMemo, useRef } from 'react';
 import uPlot from 'uplot';
 
 import { colorManipulator, DataFrame, DataFrameFieldIndex, DataFrameView, TimeZone } from '@grafana/data';
 import { EventsCanvas, UPlotConfigBuilder, useTheme2 } from '@grafana/ui';
 
 import { AnnotationMarker } from './annotations/AnnotationMarker';
+import { clusterAnnotations } from './annotations/clusterAnnotations';
 import { AnnotationsDataFrameViewDTO } from './types';
 
 interface AnnotationsPluginProps {
@@ -19,11 +20,14 @@ export const AnnotationsPlugin = ({ annotations, timeZone, config }: Annotations
 
   const annotationsRef = useRef<Array<DataFrameView<AnnotationsDataFrameViewDTO>>>();
 
+  // Merge annotations into clusters when there are more than the configured maximum number of rendered annotations
+  const renderedAnnotations = useMemo(() => clusterAnnotations(annotations), [annotations]);
+
   // Update annotations views when new annotations came
   useEffect(() => {
     const views: Array<DataFrameView<AnnotationsDataFrameViewDTO>> = [];
 
-    for (const frame of annotations) {
+    for (const frame of renderedAnnotations) {
       views.push(new DataFrameView(frame));
     }
 
@@ -33,7 +37,7 @@ export const AnnotationsPlugin = ({ annotations, timeZone, config }: Annotations
       // clear on unmount
       annotationsRef.current = [];
     };
-  }, [annotations]);
+  }, [renderedAnnotations]);
 
   useLayoutEffect(() => {
     config.addHook('init', (u) => {
@@ -148,7 +152,7 @@ export const AnnotationsPlugin = ({ annotations, timeZone, config }: Annotations
     <EventsCanvas
       id="annotations"
       config={config}
-      events={annotations}
+      events={renderedAnnotations}
       renderEventMarker={renderMarker}
       mapEventToXYCoords={mapAnnotationToXYCoords}
     />
@@ -97,8 +97,8 @@ export function AnnotationMarker({ annotation, timeZone, width }: Props) {
         timeFormatter={timeFormatter}
         onEdit={onAnnotationEdit}
         onDelete={onAnnotationDelete}
-        canEdit={canEditAnnotations!(annotation.dashboardUID)}
-        canDelete={canDeleteAnnotations!(annotation.dashboardUID)}
+        canEdit={!annotation.clusterSize && canEditAnnotations!(annotation.dashboardUID)}
+        canDelete={!annotation.clusterSize && canDeleteAnnotations!(annotation.dashboardUID)}
       />
     );
   }, [canEditAnnotations, canDeleteAnnotations, onAnnotationDelete, onAnnotationEdit, timeFormatter, annotation]);
@@ -0,0 +1,57 @@
+import { arrayToDataFrame, DataFrameView } from '@grafana/data';
+
+import { AnnotationsDataFrameViewDTO } from '../types';
+
+import { clusterAnnotations } from './clusterAnnotations';
+
+const createAnnotations = (times: number[]) =>
+  arrayToDataFrame(
+    times.map((time) => ({ id: `${time}`, time, timeEnd: time, text: `annotation ${time}`, color: 'red', tags: ['a'] }))
+  );
+
+const getRendered = (times: number[], maxAnnotations: number) => {
+  const frames = clusterAnnotations([createAnnotations(times)], maxAnnotations);
+  return frames.flatMap((frame) => new DataFrameView<AnnotationsDataFrameViewDTO>(frame).toArray());
+};
+
+describe('clusterAnnotations', () => {
+  it('should keep the annotations when there is no limit', () => {
+    const frames = [createAnnotations([1, 2, 3])];
+
+    expect(clusterAnnotations(frames, 0)).toBe(frames);
+  });
+
+  it('should keep the annotations up to the limit', () => {
+    const frames = [createAnnotations([1, 2, 3])];
+
+    expect(clusterAnnotations(frames, 3)).toBe(frames);
+  });
+
+  it('should cluster neighbouring annotations beyond the limit', () => {
+    const rendered = getRendered([50, 10, 40, 20, 30, 60], 3);
+
+    expect(rendered).toHaveLength(3);
+    expect(rendered).toMatchObject([
+      { time: 10, timeEnd: 20, isRegion: true, text: '2 annotations', clusterSize: 2 },
+      { time: 30, timeEnd: 40, isRegion: true, text: '2 annotations', clusterSize: 2 },
+      { time: 50, timeEnd: 60, isRegion: true, text: '2 annotations', clusterSize: 2 },
+    ]);
+  });
+
+  it('should keep annotations that are left over as they are', () => {
+    const rendered = getRendered([10, 20, 30, 40, 50], 3);
+
+    expect(rendered).toHaveLength(3);
+    expect(rendered[2]).toMatchObject({ id: '50', time: 50, text: 'annotation 50' });
+    expect(rendered[2].clusterSize).toBeUndefined();
+  });
+
+  it('should cluster annotations across frames', () => {
+    const frames = clusterAnnotations([createAnnotations([10, 30]), createAnnotations([20, 40])], 2);
+    const rendered = frames.flatMap((frame) => new DataFrameView<AnnotationsDataFrameViewDTO>(frame).toArray());
+
+    expect(rendered).toHaveLength(2);
+    expect(rendered[0]).toMatchObject({ time: 10, timeEnd: 20, clusterSize: 2, tags: ['a'] });
+    expect(rendered[1]).toMatchObject({ time: 30, timeEnd: 40, clusterSize: 2 });
+  });
+});
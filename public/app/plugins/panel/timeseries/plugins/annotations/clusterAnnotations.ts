@@ -0,0 +1,59 @@
+import { uniq } from 'lodash';
+
+import { arrayToDataFrame, DataFrame, DataFrameView } from '@grafana/data';
+import { config } from '@grafana/runtime';
+
+import { AnnotationsDataFrameViewDTO } from '../types';
+
+/**
+ * Merges neighbouring annotations into clusters when there are more than maxAnnotations of them, so that no more
+ * than maxAnnotations markers are rendered. A cluster spans from its first to its last annotation.
+ */
+export function clusterAnnotations(frames: DataFrame[], maxAnnotations = config.maxRenderedAnnotations): DataFrame[] {
+  // 0 means there is no configured limit
+  if (!maxAnnotations || frames.reduce((count, frame) => count + frame.length, 0) <= maxAnnotations) {
+    return frames;
+  }
+
+  const annotations: AnnotationsDataFrameViewDTO[] = [];
+  for (const frame of frames) {
+    const view = new DataFrameView<AnnotationsDataFrameViewDTO>(frame);
+    for (let i = 0; i < view.length; i++) {
+      const annotation = view.get(i);
+      if (annotation.time) {
+        // view rows are reused, so copy the values
+        annotations.push({ ...annotation });
+      }
+    }
+  }
+
+  if (annotations.length <= maxAnnotations) {
+    return frames;
+  }
+
+  annotations.sort((a, b) => a.time - b.time);
+
+  const clusterSize = Math.ceil(annotations.length / maxAnnotations);
+  const rendered: Array<Partial<AnnotationsDataFrameViewDTO>> = [];
+  for (let i = 0; i < annotations.length; i += clusterSize) {
+    const cluster = annotations.slice(i, i + clusterSize);
+    if (cluster.length === 1) {
+      rendered.push(cluster[0]);
+      continue;
+    }
+
+    const time = cluster[0].time;
+    const timeEnd = Math.max(...cluster.map((annotation) => annotation.timeEnd || annotation.time));
+    rendered.push({
+      time,
+      timeEnd,
+      isRegion: timeEnd > time,
+      color: cluster[0].color,
+      text: `${cluster.length} annotations`,
+      tags: uniq(cluster.flatMap((annotation) => annotation.tags ?? [])),
+      clusterSize: cluster.length,
+    });
+  }
+
+  return [arrayToDataFrame(rendered, uniq(rendered.flatMap((annotation) => Object.keys(annotation))))];
+}
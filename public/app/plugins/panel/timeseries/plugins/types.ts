@@ -14,4 +14,6 @@ export interface AnnotationsDataFrameViewDTO {
   login?: string;
   avatarUrl?: string;
   isRegion?: boolean;
+  /** Number of annotations merged into this one, set on clusters only */
+  clusterSize?: number;
 }
@@ -126,6 +126,8 @@ export enum AccessControlAction {
   ActionAPIKeysCreate = 'apikeys:create',
   ActionAPIKeysDelete = 'apikeys:delete',
 
+  AnnotationsCreate = 'annotations:create',
+
   PluginsInstall = 'plugins:install',
   PluginsWrite = 'plugins:write',
 